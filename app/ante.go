@@ -3,6 +3,7 @@ package app
 import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	contractaccountkeeper "github.com/Nolus-Protocol/nolus-core/x/contractaccount/keeper"
 	taxkeeper "github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
 	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,15 +19,19 @@ import (
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
-	AccountKeeper     ante.AccountKeeper
-	BankKeeper        taxtypes.BankKeeper
-	FeegrantKeeper    ante.FeegrantKeeper
-	TaxKeeper         taxkeeper.Keeper
-	TxCounterStoreKey sdk.StoreKey
-	WasmConfig        *wasmTypes.WasmConfig
-	SignModeHandler   authsigning.SignModeHandler
-	SigGasConsumer    func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
-	IBCKeeper         *keeper.Keeper
+	AccountKeeper         ante.AccountKeeper
+	BankKeeper            taxtypes.BankKeeper
+	FeegrantKeeper        ante.FeegrantKeeper
+	TaxKeeper             taxkeeper.Keeper
+	ContractAccountKeeper contractaccountkeeper.Keeper
+	TxCounterStoreKey     sdk.StoreKey
+	WasmConfig            *wasmTypes.WasmConfig
+	SignModeHandler       authsigning.SignModeHandler
+	SigGasConsumer        func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+	IBCKeeper             *keeper.Keeper
+	// CheckTxDecorators are optional extra decorators applied in CheckTx only, e.g. the ones enabled by the AnteConfig.
+	// They filter the mempool of the node without affecting consensus.
+	CheckTxDecorators []sdk.AnteDecorator
 }
 
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
@@ -56,21 +61,38 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit), // after setup context to enforce limits early
 		wasmkeeper.NewCountTXDecorator(options.TxCounterStoreKey),
 		ante.NewRejectExtensionOptionsDecorator(),
-		ante.NewMempoolFeeDecorator(),
+		taxkeeper.NewMsgFilterDecorator(options.TaxKeeper), // governance disallowed message types, including the ones nested in authz
+		// the IBC relayer messages are exempt from both the validator's local and the consensus minimum gas price
+		taxkeeper.NewBypassFeeDecorator(options.TaxKeeper,
+			ante.NewMempoolFeeDecorator(),
+			taxkeeper.NewMinGasPriceDecorator(options.TaxKeeper), // consensus minimum gas price, enforced on top of the validator's local one
+		),
+		taxkeeper.NewPriorityDecorator(options.TaxKeeper), // oracle price feeds first, then by gas price
+	}
+
+	// the node local mempool checks run before any state change, the decorators affecting consensus stay fixed
+	for _, decorator := range options.CheckTxDecorators {
+		anteDecorators = append(anteDecorators, NewCheckTxOnlyDecorator(decorator))
+	}
+
+	anteDecorators = append(anteDecorators,
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
 		// Tax calculation must be called after fees
-		taxkeeper.NewDeductTaxDecorator(options.AccountKeeper, options.BankKeeper, options.TaxKeeper),
-		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
-		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		taxkeeper.NewDeductTaxDecorator(options.AccountKeeper, options.TaxKeeper),
+		// the transactions of the contract accounts are authenticated by their contracts instead of the public key signatures
+		contractaccountkeeper.NewContractAuthDecorator(options.ContractAccountKeeper, options.SignModeHandler,
+			ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+			ante.NewValidateSigCountDecorator(options.AccountKeeper),
+			ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+			ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewAnteDecorator(options.IBCKeeper),
-	}
+	)
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
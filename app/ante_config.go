@@ -0,0 +1,90 @@
+package app
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// Ante config related flags, set either in the [ante] section of app.toml or on the start command.
+const (
+	flagAnteMaxMemoCharacters  = "ante.max_memo_characters"
+	flagAnteMaxMsgsPerTx       = "ante.max_msgs_per_tx"
+	flagAnteDisallowedMsgTypes = "ante.disallowed_msg_types"
+)
+
+// AnteConfigTemplate is the [ante] section of the app.toml template with the default config.
+const AnteConfigTemplate = `
+###############################################################################
+###                         Ante Configuration                              ###
+###############################################################################
+
+[ante]
+
+# The max length of the memo of the txs accepted in the mempool. Set to 0 to disable.
+max_memo_characters = 0
+
+# The max number of messages of the txs accepted in the mempool. Set to 0 to disable.
+max_msgs_per_tx = 0
+
+# The type URLs of the messages rejected from the mempool, e.g. ["/cosmos.bank.v1beta1.MsgMultiSend"].
+disallowed_msg_types = []
+`
+
+// AnteConfig is the node local configuration of the ante handler checks applied in CheckTx only.
+// They keep the mempool clean without affecting consensus, so every node may set its own.
+type AnteConfig struct {
+	// MaxMemoCharacters is the max length of a tx memo. Zero disables the check.
+	MaxMemoCharacters uint64
+	// MaxMsgsPerTx is the max number of messages in a tx. Zero disables the check.
+	MaxMsgsPerTx uint64
+	// DisallowedMsgTypes are the type URLs of the messages rejected from the mempool.
+	DisallowedMsgTypes []string
+}
+
+// AddAnteFlags adds the ante config flags to the start command.
+func AddAnteFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Uint64(flagAnteMaxMemoCharacters, 0, "Sets the max length of the memo of the txs accepted in the mempool. Set to 0 to disable.")
+	startCmd.Flags().Uint64(flagAnteMaxMsgsPerTx, 0, "Sets the max number of messages of the txs accepted in the mempool. Set to 0 to disable.")
+	startCmd.Flags().StringSlice(flagAnteDisallowedMsgTypes, nil, "Sets the type URLs of the messages rejected from the mempool")
+}
+
+// ReadAnteConfig reads the ante config from the app options.
+func ReadAnteConfig(opts servertypes.AppOptions) (AnteConfig, error) {
+	var (
+		cfg AnteConfig
+		err error
+	)
+	if v := opts.Get(flagAnteMaxMemoCharacters); v != nil {
+		if cfg.MaxMemoCharacters, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagAnteMaxMsgsPerTx); v != nil {
+		if cfg.MaxMsgsPerTx, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagAnteDisallowedMsgTypes); v != nil {
+		if cfg.DisallowedMsgTypes, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
+
+// Decorators returns the CheckTx only decorators enabled by the config.
+func (c AnteConfig) Decorators() []sdk.AnteDecorator {
+	var decorators []sdk.AnteDecorator
+	if c.MaxMemoCharacters > 0 {
+		decorators = append(decorators, NewMaxMemoDecorator(c.MaxMemoCharacters))
+	}
+	if c.MaxMsgsPerTx > 0 {
+		decorators = append(decorators, NewMaxMsgsDecorator(c.MaxMsgsPerTx))
+	}
+	if len(c.DisallowedMsgTypes) > 0 {
+		decorators = append(decorators, NewDisallowedMsgTypesDecorator(c.DisallowedMsgTypes))
+	}
+	return decorators
+}
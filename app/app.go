@@ -73,6 +73,9 @@ import (
 	icacontroller "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/keeper"
 	icacontrollertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v4/modules/core"
@@ -92,14 +95,41 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	appparams "github.com/Nolus-Protocol/nolus-core/app/params"
+	contractmanagerwrapper "github.com/Nolus-Protocol/nolus-core/custom/contractmanager"
 	"github.com/Nolus-Protocol/nolus-core/docs"
 	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist"
+	blocklistclient "github.com/Nolus-Protocol/nolus-core/x/blocklist/client"
+	blocklistkeeper "github.com/Nolus-Protocol/nolus-core/x/blocklist/keeper"
+	blocklisttypes "github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount"
+	contractaccountkeeper "github.com/Nolus-Protocol/nolus-core/x/contractaccount/keeper"
+	contractaccounttypes "github.com/Nolus-Protocol/nolus-core/x/contractaccount/types"
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures"
+	contractfailureskeeper "github.com/Nolus-Protocol/nolus-core/x/contractfailures/keeper"
+	contractfailurestypes "github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers"
+	contracttransferskeeper "github.com/Nolus-Protocol/nolus-core/x/contracttransfers/keeper"
+	contracttransferstypes "github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks"
+	ibchookskeeper "github.com/Nolus-Protocol/nolus-core/x/ibchooks/keeper"
+	ibchookstypes "github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+	"github.com/Nolus-Protocol/nolus-core/x/icacallbacks"
 	"github.com/Nolus-Protocol/nolus-core/x/mint"
 	mintkeeper "github.com/Nolus-Protocol/nolus-core/x/mint/keeper"
 	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward"
+	packetforwardkeeper "github.com/Nolus-Protocol/nolus-core/x/packetforward/keeper"
+	packetforwardtypes "github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit"
+	ratelimitkeeper "github.com/Nolus-Protocol/nolus-core/x/ratelimit/keeper"
+	ratelimittypes "github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
 	"github.com/Nolus-Protocol/nolus-core/x/tax"
 	taxmodulekeeper "github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
 	taxmoduletypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory"
+	tokenfactorykeeper "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"
@@ -184,6 +214,7 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		distrclient.ProposalHandler,
 		upgradeclient.ProposalHandler,
 		upgradeclient.CancelProposalHandler,
+		blocklistclient.UpdateBlocklistProposalHandler,
 	)
 
 	govProposalHandlers = append(govProposalHandlers, wasmclient.ProposalHandlers...)
@@ -217,7 +248,14 @@ var (
 		vesting.AppModuleBasic{},
 		wasm.AppModuleBasic{},
 		tax.AppModuleBasic{},
-		ica.AppModuleBasic{},
+		blocklist.AppModuleBasic{},
+		contractaccount.AppModuleBasic{},
+		tokenfactory.AppModuleBasic{},
+		contracttransfers.AppModuleBasic{},
+		contractfailures.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
+		icaAppModuleBasic{},
 		interchaintxs.AppModuleBasic{},
 		interchainqueries.AppModuleBasic{},
 		feerefunder.AppModuleBasic{},
@@ -237,6 +275,7 @@ var (
 		icatypes.ModuleName:               nil,
 		interchainqueriestypes.ModuleName: nil,
 		feetypes.ModuleName:               nil,
+		tokenfactorytypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
 	}
 )
 
@@ -287,6 +326,7 @@ type App struct {
 	ParamsKeeper        paramskeeper.Keeper
 	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	ICAControllerKeeper icacontrollerkeeper.Keeper
+	ICAHostKeeper       icahostkeeper.Keeper
 	EvidenceKeeper      evidencekeeper.Keeper
 	TransferKeeper      wrapkeeper.KeeperTransferWrapper
 	FeeKeeper           *feekeeper.Keeper
@@ -297,8 +337,17 @@ type App struct {
 	ScopedInterchainTxsKeeper capabilitykeeper.ScopedKeeper
 	ScopedWasmKeeper          capabilitykeeper.ScopedKeeper
 	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
-
-	TaxKeeper taxmodulekeeper.Keeper
+	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
+
+	TaxKeeper               taxmodulekeeper.Keeper
+	BlocklistKeeper         blocklistkeeper.Keeper
+	ContractAccountKeeper   contractaccountkeeper.Keeper
+	TokenFactoryKeeper      tokenfactorykeeper.Keeper
+	ContractTransfersKeeper contracttransferskeeper.Keeper
+	ContractFailuresKeeper  contractfailureskeeper.Keeper
+	RateLimitKeeper         ratelimitkeeper.Keeper
+	PacketForwardKeeper     packetforwardkeeper.Keeper
+	IBCHooksKeeper          ibchookskeeper.Keeper
 
 	InterchainTxsKeeper     interchaintxskeeper.Keeper
 	InterchainQueriesKeeper interchainquerieskeeper.Keeper
@@ -311,6 +360,9 @@ type App struct {
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// module configurator
+	configurator module.Configurator
 }
 
 // New returns a reference to an initialized Gaia.
@@ -340,11 +392,13 @@ func New(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey,
 		upgradetypes.StoreKey, evidencetypes.StoreKey, ibctransfertypes.StoreKey,
-		taxmoduletypes.StoreKey, icacontrollertypes.StoreKey, capabilitytypes.StoreKey,
+		taxmoduletypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
 		interchainqueriestypes.StoreKey, contractmanagermoduletypes.StoreKey, interchaintxstypes.StoreKey,
-		wasm.StoreKey, feetypes.StoreKey,
+		wasm.StoreKey, feetypes.StoreKey, blocklisttypes.StoreKey, tokenfactorytypes.StoreKey,
+		contracttransferstypes.StoreKey, ratelimittypes.StoreKey, packetforwardtypes.StoreKey,
+		contractfailurestypes.StoreKey, ibchookstypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, taxmoduletypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, feetypes.MemStoreKey)
 
 	app := &App{
@@ -374,6 +428,7 @@ func New(
 	app.ScopedTransferKeeper = app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	app.ScopedInterchainTxsKeeper = app.CapabilityKeeper.ScopeToModule(interchaintxstypes.ModuleName)
 	app.ScopedICAControllerKeeper = app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
+	app.ScopedICAHostKeeper = app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	app.ScopedWasmKeeper = app.CapabilityKeeper.ScopeToModule(wasm.ModuleName)
 
 	// seal capabilities after scoping modules
@@ -387,13 +442,23 @@ func New(
 		authtypes.ProtoBaseAccount,
 		maccPerms,
 	)
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	app.BlocklistKeeper = *blocklistkeeper.NewKeeper(
 		appCodec,
-		keys[banktypes.StoreKey],
-		app.AccountKeeper,
-		app.GetSubspace(banktypes.ModuleName),
-		app.BlockedAddrs(),
+		keys[blocklisttypes.StoreKey],
 	)
+	restrictedBankKeeper := blocklistkeeper.NewRestrictedBankKeeper(
+		bankkeeper.NewBaseKeeper(
+			appCodec,
+			keys[banktypes.StoreKey],
+			app.AccountKeeper,
+			app.GetSubspace(banktypes.ModuleName),
+			app.BlockedAddrs(),
+		),
+		app.BlocklistKeeper,
+	)
+	// every keeper moving the funds of the users, e.g. wasm, IBC transfer, staking and tokenfactory,
+	// is created with the restricted bank keeper, so a blocked address can not move funds through any of them
+	app.BankKeeper = restrictedBankKeeper
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -467,6 +532,16 @@ func New(
 	)
 	contractManagerModule := contractmanager.NewAppModule(appCodec, app.ContractManagerKeeper)
 
+	// the contract callbacks are made through the contract failures keeper, which keeps the sudo messages of the failed ones
+	app.ContractFailuresKeeper = *contractfailureskeeper.NewKeeper(
+		appCodec,
+		keys[contractfailurestypes.StoreKey],
+		app.GetSubspace(contractfailurestypes.ModuleName),
+		contractmanagerwrapper.NewKeeper(app.ContractManagerKeeper, keys[contractmanagermoduletypes.StoreKey]),
+		&app.WasmKeeper,
+	)
+	contractFailuresModule := contractfailures.NewAppModule(appCodec, app.ContractFailuresKeeper)
+
 	app.FeeKeeper = feekeeper.NewKeeper(
 		appCodec,
 		keys[feetypes.StoreKey],
@@ -477,21 +552,58 @@ func New(
 	)
 	feeModule := feerefunder.NewAppModule(appCodec, *app.FeeKeeper, app.AccountKeeper, app.BankKeeper)
 
+	// the rate limits wrap the channel keeper the transfers are sent through, enforcing the outflow quotas
+	app.RateLimitKeeper = *ratelimitkeeper.NewKeeper(
+		appCodec,
+		keys[ratelimittypes.StoreKey],
+		app.GetSubspace(ratelimittypes.ModuleName),
+		app.BankKeeper,
+		app.IBCKeeper.ChannelKeeper,
+	)
+	rateLimitModule := ratelimit.NewAppModule(appCodec, app.RateLimitKeeper)
+
+	// the ibc hooks wrap the rate limits, recording the contracts to call back with the outcome of their transfers
+	app.IBCHooksKeeper = *ibchookskeeper.NewKeeper(
+		keys[ibchookstypes.StoreKey],
+		wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper),
+		&app.WasmKeeper,
+		app.RateLimitKeeper,
+	)
+
+	// the transfers are called back through the contract transfers keeper, which adds the callback id of the contracts
+	app.ContractTransfersKeeper = *contracttransferskeeper.NewKeeper(
+		appCodec,
+		keys[contracttransferstypes.StoreKey],
+		app.ContractFailuresKeeper,
+	)
+	contractTransfersModule := contracttransfers.NewAppModule(appCodec, app.ContractTransfersKeeper)
+
 	app.TransferKeeper = wrapkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.IBCHooksKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.ScopedTransferKeeper,
 		app.FeeKeeper,
-		app.ContractManagerKeeper,
+		app.ContractTransfersKeeper,
 	)
 	transferModule := transferSudo.NewAppModule(app.TransferKeeper)
 
+	// the forwards are sent by the ibc transfer keeper, as the neutron wrapper drops the memo
+	app.PacketForwardKeeper = *packetforwardkeeper.NewKeeper(
+		appCodec,
+		keys[packetforwardtypes.StoreKey],
+		app.TransferKeeper.Keeper,
+		app.BankKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.ScopedTransferKeeper,
+	)
+	packetForwardModule := packetforward.NewAppModule(appCodec, app.PacketForwardKeeper)
+
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -506,7 +618,8 @@ func New(
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
-		AddRoute(distrtypes.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper))
+		AddRoute(distrtypes.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
+		AddRoute(blocklisttypes.RouterKey, blocklist.NewBlocklistProposalHandler(app.BlocklistKeeper))
 
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec,
@@ -518,7 +631,19 @@ func New(
 		app.ScopedICAControllerKeeper,
 		app.MsgServiceRouter(),
 	)
-	icaModule := ica.NewAppModule(&app.ICAControllerKeeper, nil)
+
+	// other chains control accounts on Nolus, executing the governance allowed messages only
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec,
+		keys[icahosttypes.StoreKey],
+		app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		app.ScopedICAHostKeeper,
+		app.MsgServiceRouter(),
+	)
+	icaModule := ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
 
 	app.InterchainQueriesKeeper = *interchainquerieskeeper.NewKeeper(
 		appCodec,
@@ -541,7 +666,7 @@ func New(
 		app.IBCKeeper.ChannelKeeper,
 		app.ICAControllerKeeper,
 		app.ScopedInterchainTxsKeeper,
-		app.ContractManagerKeeper,
+		app.ContractFailuresKeeper,
 		app.FeeKeeper,
 	)
 	interchainTxsModule := interchaintxs.NewAppModule(appCodec, app.InterchainTxsKeeper, app.AccountKeeper, app.BankKeeper)
@@ -559,7 +684,9 @@ func New(
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,migrate,upgrade,neutron,cosmwasm_1_1"
-	wasmOpts = append(wasmbinding.RegisterCustomPlugins(&app.InterchainTxsKeeper, &app.InterchainQueriesKeeper, app.TransferKeeper), wasmOpts...)
+	wasmOpts = append(wasmbinding.RegisterCustomPlugins(&app.InterchainTxsKeeper, app.ICAControllerKeeper, app.IBCKeeper.ChannelKeeper, &app.InterchainQueriesKeeper, app.TransferKeeper, &app.ContractTransfersKeeper, &app.ContractFailuresKeeper, app.GetSubspace(wasmbinding.ParamsSubspace), &app.GovKeeper, &app.MintKeeper, &app.TaxKeeper, &app.TokenFactoryKeeper, app.StakingKeeper, app.GRPCQueryRouter(), appCodec), wasmOpts...)
+	// the authenticator contracts are called in the ante handler, where they may not dispatch messages
+	wasmOpts = append(wasmOpts, wasmkeeper.WithMessageHandlerDecorator(contractaccountkeeper.NewMessengerDecorator()))
 	app.WasmKeeper = wasm.NewKeeper(
 		appCodec,
 		keys[wasm.StoreKey],
@@ -572,7 +699,8 @@ func New(
 		&app.IBCKeeper.PortKeeper,
 		app.ScopedWasmKeeper,
 		app.TransferKeeper,
-		app.MsgServiceRouter(),
+		// the messages dispatched by the contracts are subject to the governance disallowed message types
+		taxmodulekeeper.NewMsgFilterRouter(app.MsgServiceRouter(), &app.TaxKeeper),
 		app.GRPCQueryRouter(),
 		wasmDir,
 		wasmConfig,
@@ -599,20 +727,55 @@ func New(
 		appCodec,
 		keys[taxmoduletypes.StoreKey],
 		keys[taxmoduletypes.MemStoreKey],
+		tkeys[taxmoduletypes.TStoreKey],
 		app.GetSubspace(taxmoduletypes.ModuleName),
 	)
 	taxModule := tax.NewAppModule(appCodec, app.TaxKeeper, app.AccountKeeper, app.BankKeeper)
+	blocklistModule := blocklist.NewAppModule(appCodec, app.BlocklistKeeper)
+
+	app.ContractAccountKeeper = *contractaccountkeeper.NewKeeper(
+		appCodec,
+		app.AccountKeeper,
+		&app.WasmKeeper,
+	)
+	contractAccountModule := contractaccount.NewAppModule(appCodec, app.ContractAccountKeeper)
 
-	transferIBCModule := transferSudo.NewIBCModule(app.TransferKeeper)
+	app.TokenFactoryKeeper = *tokenfactorykeeper.NewKeeper(
+		appCodec,
+		keys[tokenfactorytypes.StoreKey],
+		app.GetSubspace(tokenfactorytypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.TaxKeeper,
+	)
+	tokenFactoryModule := tokenfactory.NewAppModule(appCodec, app.TokenFactoryKeeper)
+
+	var transferIBCModule ibcporttypes.IBCModule
+
+	transferIBCModule = transferSudo.NewIBCModule(app.TransferKeeper)
+	transferIBCModule = contractfailures.NewIBCMiddleware(transferIBCModule)
+	transferIBCModule = ratelimit.NewIBCMiddleware(transferIBCModule, app.RateLimitKeeper)
+	transferIBCModule = contracttransfers.NewIBCMiddleware(transferIBCModule, app.ContractTransfersKeeper)
+	transferIBCModule = ibchooks.NewIBCMiddleware(transferIBCModule, app.IBCHooksKeeper)
+	transferIBCModule = packetforward.NewIBCMiddleware(transferIBCModule, app.PacketForwardKeeper)
+	transferIBCModule = blocklist.NewIBCMiddleware(transferIBCModule, app.BlocklistKeeper)
 
 	var icaControllerStack ibcporttypes.IBCModule
 
 	icaControllerStack = interchaintxs.NewIBCModule(app.InterchainTxsKeeper)
+	icaControllerStack = contractfailures.NewIBCMiddleware(icaControllerStack)
+	icaControllerStack = icacallbacks.NewIBCMiddleware(icaControllerStack, wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper))
 	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
 
+	var icaHostStack ibcporttypes.IBCModule
+
+	icaHostStack = icahost.NewIBCModule(app.ICAHostKeeper)
+	icaHostStack = tax.NewIBCMiddleware(icaHostStack, app.TaxKeeper, appCodec)
+
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
 		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
 		AddRoute(interchaintxstypes.ModuleName, icaControllerStack).
 		AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper))
@@ -636,7 +799,7 @@ func New(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		blocklist.NewBankAppModule(appCodec, restrictedBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
@@ -654,6 +817,13 @@ func New(
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		taxModule,
+		blocklistModule,
+		contractAccountModule,
+		tokenFactoryModule,
+		contractTransfersModule,
+		contractFailuresModule,
+		rateLimitModule,
+		packetForwardModule,
 		icaModule,
 		interchainQueriesModule,
 		interchainTxsModule,
@@ -673,7 +843,9 @@ func New(
 		paramstypes.ModuleName, ibctransfertypes.ModuleName, crisistypes.ModuleName,
 		taxmoduletypes.ModuleName, govtypes.ModuleName, icatypes.ModuleName,
 		interchaintxstypes.ModuleName, interchainqueriestypes.ModuleName, contractmanagermoduletypes.ModuleName,
-		wasm.ModuleName, feetypes.ModuleName,
+		wasm.ModuleName, feetypes.ModuleName, blocklisttypes.ModuleName, contractaccounttypes.ModuleName,
+		tokenfactorytypes.ModuleName, contracttransferstypes.ModuleName, ratelimittypes.ModuleName,
+		packetforwardtypes.ModuleName, contractfailurestypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -683,7 +855,9 @@ func New(
 		ibctransfertypes.ModuleName,
 		genutiltypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, taxmoduletypes.ModuleName,
 		icatypes.ModuleName, interchaintxstypes.ModuleName, interchainqueriestypes.ModuleName,
-		contractmanagermoduletypes.ModuleName, wasm.ModuleName, feetypes.ModuleName,
+		contractmanagermoduletypes.ModuleName, wasm.ModuleName, feetypes.ModuleName, blocklisttypes.ModuleName,
+		contractaccounttypes.ModuleName, tokenfactorytypes.ModuleName, contracttransferstypes.ModuleName,
+		ratelimittypes.ModuleName, packetforwardtypes.ModuleName, contractfailurestypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -703,6 +877,13 @@ func New(
 		minttypes.ModuleName,
 		crisistypes.ModuleName,
 		taxmoduletypes.ModuleName,
+		blocklisttypes.ModuleName,
+		contractaccounttypes.ModuleName,
+		tokenfactorytypes.ModuleName,
+		contracttransferstypes.ModuleName,
+		ratelimittypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		contractfailurestypes.ModuleName,
 		ibchost.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -720,11 +901,12 @@ func New(
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
 
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		blocklist.NewBankAppModule(appCodec, restrictedBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
@@ -755,16 +937,23 @@ func New(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
+	anteConfig, err := ReadAnteConfig(appOpts)
+	if err != nil {
+		panic("error while reading ante config: " + err.Error())
+	}
+
 	anteHandler, err := NewAnteHandler(
 		HandlerOptions{
-			AccountKeeper:     app.AccountKeeper,
-			BankKeeper:        app.BankKeeper,
-			TaxKeeper:         app.TaxKeeper,
-			TxCounterStoreKey: keys[wasm.StoreKey],
-			WasmConfig:        &wasmConfig,
-			SignModeHandler:   encodingConfig.TxConfig.SignModeHandler(),
-			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
-			IBCKeeper:         app.IBCKeeper,
+			AccountKeeper:         app.AccountKeeper,
+			BankKeeper:            app.BankKeeper,
+			TaxKeeper:             app.TaxKeeper,
+			ContractAccountKeeper: app.ContractAccountKeeper,
+			TxCounterStoreKey:     keys[wasm.StoreKey],
+			WasmConfig:            &wasmConfig,
+			SignModeHandler:       encodingConfig.TxConfig.SignModeHandler(),
+			SigGasConsumer:        ante.DefaultSigVerificationGasConsumer,
+			IBCKeeper:             app.IBCKeeper,
+			CheckTxDecorators:     anteConfig.Decorators(),
 		},
 	)
 	if err != nil {
@@ -818,6 +1007,18 @@ func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	return app.mm.BeginBlock(ctx, req)
 }
 
+// CheckTx checks a transaction and sets its priority in the Tendermint priority mempool,
+// as assigned by the ante handler. The mempool orders txs by priority with mempool.version = "v1" only.
+func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.BaseApp.CheckTx(req)
+	priority := app.TaxKeeper.PopTxPriority(req.Tx)
+	if res.IsOK() {
+		res.Priority = priority
+	}
+
+	return res
+}
+
 // EndBlocker application updates every end block.
 func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	return app.mm.EndBlock(ctx, req)
@@ -830,6 +1031,8 @@ func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 		panic(err)
 	}
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	// the custom bindings have no module genesis, so the stargate queries they serve are whitelisted here
+	wasmbinding.SetStargateQueries(ctx, app.GetSubspace(wasmbinding.ParamsSubspace), wasmbinding.DefaultStargateQueries())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -950,6 +1153,9 @@ func initParamsKeeper(
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
 
 	paramsKeeper.Subspace(taxmoduletypes.ModuleName)
+	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
+	paramsKeeper.Subspace(ratelimittypes.ModuleName)
+	paramsKeeper.Subspace(contractfailurestypes.ModuleName)
 	paramsKeeper.Subspace(authtypes.ModuleName)
 	paramsKeeper.Subspace(banktypes.ModuleName)
 	paramsKeeper.Subspace(stakingtypes.ModuleName)
@@ -962,9 +1168,23 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(wasm.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(feetypes.ModuleName)
 	paramsKeeper.Subspace(interchaintxstypes.ModuleName)
 	paramsKeeper.Subspace(interchainqueriestypes.ModuleName)
+	paramsKeeper.Subspace(wasmbinding.ParamsSubspace)
 
 	return paramsKeeper
 }
+
+// GetStakingKeeper implements the TestingApp interface.
+func (app *App) GetStakingKeeper() stakingkeeper.Keeper { return app.StakingKeeper }
+
+// GetIBCKeeper implements the TestingApp interface.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper { return app.IBCKeeper }
+
+// GetScopedIBCKeeper implements the TestingApp interface.
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper { return app.ScopedIBCKeeper }
+
+// GetTxConfig implements the TestingApp interface.
+func (app *App) GetTxConfig() client.TxConfig { return app.encodingConfig.TxConfig }
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CheckTxOnlyDecorator runs the wrapped decorator in CheckTx only, so that it
+// filters the mempool without affecting the execution of the txs in a block.
+type CheckTxOnlyDecorator struct {
+	decorator sdk.AnteDecorator
+}
+
+func NewCheckTxOnlyDecorator(decorator sdk.AnteDecorator) CheckTxOnlyDecorator {
+	return CheckTxOnlyDecorator{decorator: decorator}
+}
+
+func (d CheckTxOnlyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	return d.decorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+// MaxMemoDecorator rejects txs with a memo longer than the given number of characters.
+type MaxMemoDecorator struct {
+	maxMemoCharacters uint64
+}
+
+func NewMaxMemoDecorator(maxMemoCharacters uint64) MaxMemoDecorator {
+	return MaxMemoDecorator{maxMemoCharacters: maxMemoCharacters}
+}
+
+func (mmd MaxMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a TxWithMemo")
+	}
+
+	if memoLength := len(memoTx.GetMemo()); uint64(memoLength) > mmd.maxMemoCharacters {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge,
+			"maximum number of characters is %d but received %d characters", mmd.maxMemoCharacters, memoLength)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// MaxMsgsDecorator rejects txs with more than the given number of messages.
+type MaxMsgsDecorator struct {
+	maxMsgs uint64
+}
+
+func NewMaxMsgsDecorator(maxMsgs uint64) MaxMsgsDecorator {
+	return MaxMsgsDecorator{maxMsgs: maxMsgs}
+}
+
+func (mmd MaxMsgsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if msgs := len(tx.GetMsgs()); uint64(msgs) > mmd.maxMsgs {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"maximum number of messages is %d but received %d messages", mmd.maxMsgs, msgs)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// DisallowedMsgTypesDecorator rejects txs with any message of the given type URLs.
+type DisallowedMsgTypesDecorator struct {
+	disallowed map[string]struct{}
+}
+
+func NewDisallowedMsgTypesDecorator(msgTypeURLs []string) DisallowedMsgTypesDecorator {
+	disallowed := make(map[string]struct{}, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		disallowed[typeURL] = struct{}{}
+	}
+
+	return DisallowedMsgTypesDecorator{disallowed: disallowed}
+}
+
+func (dmd DisallowedMsgTypesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		if typeURL := sdk.MsgTypeURL(msg); dmd.isDisallowed(typeURL) {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("message type %s is not accepted by this node", typeURL))
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (dmd DisallowedMsgTypesDecorator) isDisallowed(typeURL string) bool {
+	_, found := dmd.disallowed[typeURL]
+	return found
+}
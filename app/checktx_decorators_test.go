@@ -0,0 +1,134 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestReadAnteConfig(t *testing.T) {
+	cfg, err := ReadAnteConfig(simapp.EmptyAppOptions{})
+	require.NoError(t, err)
+	require.Empty(t, cfg.Decorators())
+
+	cfg, err = ReadAnteConfig(mapAppOptions{
+		flagAnteMaxMemoCharacters:  "64",
+		flagAnteMaxMsgsPerTx:       uint64(2),
+		flagAnteDisallowedMsgTypes: []interface{}{"/cosmos.bank.v1beta1.MsgMultiSend"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, AnteConfig{
+		MaxMemoCharacters:  64,
+		MaxMsgsPerTx:       2,
+		DisallowedMsgTypes: []string{"/cosmos.bank.v1beta1.MsgMultiSend"},
+	}, cfg)
+	require.Len(t, cfg.Decorators(), 3)
+
+	_, err = ReadAnteConfig(mapAppOptions{flagAnteMaxMsgsPerTx: "many"})
+	require.Error(t, err)
+}
+
+func TestAnteConfigTemplate(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(AnteConfigTemplate)))
+
+	// the template holds the defaults, i.e. every check disabled
+	cfg, err := ReadAnteConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, AnteConfig{}, cfg)
+	require.Empty(t, cfg.Decorators())
+
+	// the keys of the template are the ones read by the app
+	require.NoError(t, v.ReadConfig(strings.NewReader(strings.Replace(AnteConfigTemplate, "max_msgs_per_tx = 0", "max_msgs_per_tx = 2", 1))))
+	cfg, err = ReadAnteConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, AnteConfig{MaxMsgsPerTx: 2}, cfg)
+}
+
+func TestCheckTxDecorators(t *testing.T) {
+	_, _, from := testdata.KeyTestPubAddr()
+	_, _, to := testdata.KeyTestPubAddr()
+	send := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("unls", 1)))
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(from, sdk.NewCoins(sdk.NewInt64Coin("unls", 1)))},
+		[]banktypes.Output{banktypes.NewOutput(to, sdk.NewCoins(sdk.NewInt64Coin("unls", 1)))},
+	)
+
+	cfg := AnteConfig{
+		MaxMemoCharacters:  8,
+		MaxMsgsPerTx:       2,
+		DisallowedMsgTypes: []string{sdk.MsgTypeURL(multiSend)},
+	}
+	var decorators []sdk.AnteDecorator
+	for _, decorator := range cfg.Decorators() {
+		decorators = append(decorators, NewCheckTxOnlyDecorator(decorator))
+	}
+	anteHandler := sdk.ChainAnteDecorators(decorators...)
+
+	testCases := []struct {
+		title  string
+		memo   string
+		msgs   []sdk.Msg
+		expErr error
+	}{
+		{
+			title: "tx within the limits should pass",
+			memo:  "memo",
+			msgs:  []sdk.Msg{send, send},
+		},
+		{
+			title:  "tx with a long memo should fail",
+			memo:   "a long memo",
+			msgs:   []sdk.Msg{send},
+			expErr: sdkerrors.ErrMemoTooLarge,
+		},
+		{
+			title:  "tx with too many messages should fail",
+			msgs:   []sdk.Msg{send, send, send},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			title:  "tx with a disallowed message should fail",
+			msgs:   []sdk.Msg{send, multiSend},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetMemo(tc.memo)
+			tx := txBuilder.GetTx()
+
+			checkCtx := sdk.NewContext(nil, tmproto.Header{}, true, nil)
+			_, err := anteHandler(checkCtx, tx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			// the checks never apply to the txs delivered in a block
+			deliverCtx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+			_, err = anteHandler(deliverCtx, tx, false)
+			require.NoError(t, err)
+		})
+	}
+}
+
+type mapAppOptions map[string]interface{}
+
+func (m mapAppOptions) Get(key string) interface{} {
+	return m[key]
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ica "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts"
+	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	transferwrappertypes "github.com/neutron-org/neutron/x/transfer/types"
+)
+
+// DefaultICAHostParams returns the interchain accounts host parameters allowing the accounts controlled by
+// other chains to hold, stake and transfer tokens, vote and execute the Nolus contracts. Governance changes
+// the allowed messages through parameter change proposals.
+func DefaultICAHostParams() icahosttypes.Params {
+	return icahosttypes.NewParams(true, []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+		sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+		sdk.MsgTypeURL(&govtypes.MsgVote{}),
+		sdk.MsgTypeURL(&wasm.MsgExecuteContract{}),
+		sdk.MsgTypeURL(&transferwrappertypes.MsgTransfer{}),
+	})
+}
+
+// icaAppModuleBasic sets the host parameters of the default genesis, as the ibc-go defaults enable the host
+// without allowing any message.
+type icaAppModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the interchain accounts genesis with the default host parameters of Nolus.
+func (icaAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := icatypes.DefaultGenesis()
+	genesis.HostGenesisState.Params = DefaultICAHostParams()
+	return cdc.MustMarshalJSON(genesis)
+}
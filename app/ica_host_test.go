@@ -0,0 +1,142 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spm/cosmoscmd"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+)
+
+const icaOwner = "owner"
+
+func getApp(chain *ibctesting.TestChain) *nolusapp.App {
+	return chain.App.(*nolusapp.App)
+}
+
+// openICAChannel opens an interchain account channel from the controller chain A to the host chain B. The
+// controller side is opened directly, as its acknowledgement is handled by the contracts owning the accounts.
+func openICAChannel(t *testing.T) *ibctesting.Path {
+	ibctesting.DefaultTestingAppInit = nolusapp.SetupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)))
+	coordinator.SetupConnections(path)
+
+	version := icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	portID, err := icatypes.NewControllerPortID(icaOwner)
+	require.NoError(t, err)
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointB.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+
+	chainA := path.EndpointA.Chain
+	channelSequence := chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(chainA.GetContext())
+	require.NoError(t, getApp(chainA).ICAControllerKeeper.RegisterInterchainAccount(chainA.GetContext(), path.EndpointA.ConnectionID, icaOwner, version))
+	chainA.NextBlock()
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+
+	channel := path.EndpointA.GetChannel()
+	channel.State = channeltypes.OPEN
+	channel.Counterparty.ChannelId = path.EndpointB.ChannelID
+	channel.Version = path.EndpointB.GetChannel().Version
+	path.EndpointA.SetChannel(channel)
+	getApp(chainA).ICAControllerKeeper.SetActiveChannelID(chainA.GetContext(), path.EndpointA.ConnectionID, portID, path.EndpointA.ChannelID)
+	chainA.NextBlock()
+	require.NoError(t, path.EndpointB.UpdateClient())
+
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	return path
+}
+
+// executeTx sends the messages to the interchain account and returns the acknowledgement of the host chain.
+func executeTx(t *testing.T, path *ibctesting.Path, sequence uint64, msgs ...sdk.Msg) channeltypes.Acknowledgement {
+	data, err := icatypes.SerializeCosmosTx(getApp(path.EndpointA.Chain).AppCodec(), msgs)
+	require.NoError(t, err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	timeout := uint64(path.EndpointB.Chain.GetContext().BlockTime().Add(time.Hour).UnixNano())
+	packet := channeltypes.NewPacket(packetData.GetBytes(), sequence,
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(), timeout)
+	require.NoError(t, path.EndpointA.SendPacket(packet))
+
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, icatypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+	return ack
+}
+
+func TestICAHost(t *testing.T) {
+	path := openICAChannel(t)
+	chainB := path.EndpointB.Chain
+	appB := getApp(chainB)
+	require.Equal(t, nolusapp.DefaultICAHostParams(), appB.ICAHostKeeper.GetParams(chainB.GetContext()))
+
+	icaAddress, found := appB.ICAHostKeeper.GetInterchainAccountAddress(chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	require.True(t, found)
+	account := sdk.MustAccAddressFromBech32(icaAddress)
+	require.NoError(t, appB.BankKeeper.SendCoins(chainB.GetContext(), chainB.SenderAccount.GetAddress(), account,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	send := banktypes.NewMsgSend(account, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	ack := executeTx(t, path, 1, send)
+	require.True(t, ack.Success(), ack.GetError())
+	require.Equal(t, int64(100), appB.BankKeeper.GetBalance(chainB.GetContext(), recipient, sdk.DefaultBondDenom).Amount.Int64())
+
+	// the messages not allowed by governance are rejected
+	deposit := govtypes.NewMsgDeposit(account, 1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	ack = executeTx(t, path, 2, deposit)
+	require.False(t, ack.Success())
+	require.Equal(t, int64(900), appB.BankKeeper.GetBalance(chainB.GetContext(), account, sdk.DefaultBondDenom).Amount.Int64())
+}
+
+func TestDefaultGenesisICAHostParams(t *testing.T) {
+	cdc := cosmoscmd.MakeEncodingConfig(nolusapp.ModuleBasics).Marshaler
+	var genesis icatypes.GenesisState
+	cdc.MustUnmarshalJSON(nolusapp.NewDefaultGenesisState(cdc)[icatypes.ModuleName], &genesis)
+
+	require.True(t, genesis.HostGenesisState.Params.HostEnabled)
+	require.NotEmpty(t, genesis.HostGenesisState.Params.AllowMessages)
+	require.Equal(t, nolusapp.DefaultICAHostParams(), genesis.HostGenesisState.Params)
+	require.NoError(t, genesis.Validate())
+}
+
+func TestUpgradeV1_45SetsICAHostParams(t *testing.T) {
+	account := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	app := nolusapp.SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{account})
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+	app.ICAHostKeeper.SetParams(ctx, icahosttypes.NewParams(false, nil))
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v0.1.45", Height: ctx.BlockHeight()})
+
+	require.Equal(t, nolusapp.DefaultICAHostParams(), app.ICAHostKeeper.GetParams(ctx))
+	require.True(t, app.ICAHostKeeper.IsBound(ctx, icatypes.PortID))
+}
@@ -1,10 +1,21 @@
 package app
 
 import (
+	"encoding/json"
+	"testing"
+	"time"
+
 	"github.com/Nolus-Protocol/nolus-core/app/params"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/spm/cosmoscmd"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -13,6 +24,7 @@ import (
 	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -38,3 +50,83 @@ func CreateTestApp(isCheckTx bool, tempDir string) (*App, sdk.Context) {
 
 	return testapp, ctx
 }
+
+// SetupWithGenesisAccounts initializes a new App with a single bonded validator and the given
+// genesis accounts and balances, and commits the genesis block.
+func SetupWithGenesisAccounts(t *testing.T, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *App {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		t.TempDir(), simapp.FlagPeriodValue, encoding,
+		simapp.EmptyAppOptions{}).(*App)
+	params.SetAddressPrefixes()
+
+	genesisState := NewDefaultGenesisState(app.AppCodec())
+	genesisState[authtypes.ModuleName] = app.AppCodec().MustMarshalJSON(authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs))
+
+	pk, err := cryptocodec.FromTmPubKeyInterface(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	require.NoError(t, err)
+
+	bondAmt := sdk.NewInt(1000000)
+	validator := stakingtypes.Validator{
+		OperatorAddress:   sdk.ValAddress(pk.Address()).String(),
+		ConsensusPubkey:   pkAny,
+		Status:            stakingtypes.Bonded,
+		Tokens:            bondAmt,
+		DelegatorShares:   sdk.OneDec(),
+		UnbondingTime:     time.Unix(0, 0).UTC(),
+		Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation: sdk.ZeroInt(),
+	}
+	delegation := stakingtypes.NewDelegation(genAccs[0].GetAddress(), pk.Address().Bytes(), sdk.OneDec())
+	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(
+		stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), []stakingtypes.Validator{validator}, []stakingtypes.Delegation{delegation}))
+
+	bondCoins := sdk.NewCoins(sdk.NewCoin(stakingtypes.DefaultParams().BondDenom, bondAmt))
+	balances = append(balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		Coins:   bondCoins,
+	})
+	totalSupply := sdk.NewCoins()
+	for _, b := range balances {
+		totalSupply = totalSupply.Add(b.Coins...)
+	}
+	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(
+		banktypes.NewGenesisState(banktypes.DefaultParams(), balances, totalSupply, []banktypes.Metadata{}))
+
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	return app
+}
+
+// SetupTestingApp creates an App for the chains of the ibc-go testing package, with the default genesis state.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encoding := cosmoscmd.MakeEncodingConfig(ModuleBasics)
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, false, map[int64]bool{},
+		simapp.DefaultNodeHome, simapp.FlagPeriodValue, encoding,
+		simapp.EmptyAppOptions{}).(*App)
+	// the transactions of the testing chains carry a zero amount fee, which the tax rejects,
+	// so they are delivered only with their own gas meter
+	app.SetAnteHandler(sdk.ChainAnteDecorators(ante.NewSetUpContextDecorator()))
+	if err := app.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+	params.SetAddressPrefixes()
+
+	// the genesis block of the testing chains has no time, which the minting cannot follow,
+	// so the testing chains start with everything minted
+	genesisState := NewDefaultGenesisState(app.AppCodec())
+	minter := minttypes.NewMinter(minttypes.TotalMonths, minttypes.MintingCap, sdk.ZeroUint(), sdk.ZeroUint())
+	genesisState[minttypes.ModuleName] = app.AppCodec().MustMarshalJSON(minttypes.NewGenesisState(minter, minttypes.DefaultParams()))
+
+	return app, genesisState
+}
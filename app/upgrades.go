@@ -5,6 +5,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahostkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	blocklisttypes "github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	contractfailurestypes "github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+	contracttransferstypes "github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+	ibchookstypes "github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+	packetforwardtypes "github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+	ratelimittypes "github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
 )
 
 func (app *App) RegisterUpgradeHandlers() {
@@ -14,6 +26,9 @@ func (app *App) RegisterUpgradeHandlers() {
 	}
 
 	app.registerUpgradeV1_43(upgradeInfo)
+	app.registerUpgradeV1_44(upgradeInfo)
+	app.registerUpgradeV1_45(upgradeInfo)
+	app.registerUpgradeV1_46(upgradeInfo)
 }
 
 // performs upgrade from v0.1.39 -> v0.1.43.
@@ -24,3 +39,52 @@ func (app *App) registerUpgradeV1_43(_ storetypes.UpgradeInfo) {
 		return fromVM, nil
 	})
 }
+
+// performs upgrade from v0.1.43 -> v0.1.44.
+func (app *App) registerUpgradeV1_44(_ storetypes.UpgradeInfo) {
+	const UpgradeV1_44Plan = "v0.1.44"
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeV1_44Plan, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Upgrade handler execution", "name", UpgradeV1_44Plan)
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+}
+
+// performs upgrade from v0.1.44 -> v0.1.45, adding the interchain accounts host, the blocklist, the token factory,
+// the contract transfers and the rate limits, and whitelisting the stargate queries of the contracts.
+// The migrations initialize the genesis of the added modules.
+func (app *App) registerUpgradeV1_45(upgradeInfo storetypes.UpgradeInfo) {
+	const UpgradeV1_45Plan = "v0.1.45"
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeV1_45Plan, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Upgrade handler execution", "name", UpgradeV1_45Plan)
+
+		// the interchain accounts module already runs the controller, so its genesis is not initialized again
+		icahostkeeper.InitGenesis(ctx, app.ICAHostKeeper, icatypes.NewHostGenesisState(nil, nil, icatypes.PortID, DefaultICAHostParams()))
+
+		wasmbinding.SetStargateQueries(ctx, app.GetSubspace(wasmbinding.ParamsSubspace), wasmbinding.DefaultStargateQueries())
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
+	if upgradeInfo.Name == UpgradeV1_45Plan && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{icahosttypes.StoreKey, blocklisttypes.StoreKey, tokenfactorytypes.StoreKey, contracttransferstypes.StoreKey, ratelimittypes.StoreKey},
+		}))
+	}
+}
+
+// performs upgrade from v0.1.45 -> v0.1.46, adding the packet forwarding and the ibc hooks callbacks of the
+// transfer stack.
+// The migrations initialize the genesis of the packetforward module.
+func (app *App) registerUpgradeV1_46(upgradeInfo storetypes.UpgradeInfo) {
+	const UpgradeV1_46Plan = "v0.1.46"
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeV1_46Plan, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Upgrade handler execution", "name", UpgradeV1_46Plan)
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
+	if upgradeInfo.Name == UpgradeV1_46Plan && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{packetforwardtypes.StoreKey, contractfailurestypes.StoreKey, ibchookstypes.StoreKey},
+		}))
+	}
+}
@@ -0,0 +1,79 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	packetforwardtypes "github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func TestUpgradeV1_45InitializesTokenFactory(t *testing.T) {
+	account := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	app := nolusapp.SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{account})
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	// the state of a chain running v0.1.44, without the token factory params and version
+	paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	paramsStore.Delete(append([]byte(tokenfactorytypes.ModuleName+"/"), tokenfactorytypes.KeyDenomCreationFee...))
+	require.Panics(t, func() { app.TokenFactoryKeeper.GetParams(ctx) })
+	upgradeStore := ctx.KVStore(app.GetKey(upgradetypes.StoreKey))
+	upgradeStore.Delete(append([]byte{upgradetypes.VersionMapByte}, []byte(tokenfactorytypes.ModuleName)...))
+	require.NotContains(t, app.UpgradeKeeper.GetModuleVersionMap(ctx), tokenfactorytypes.ModuleName)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v0.1.45", Height: ctx.BlockHeight()})
+
+	require.Equal(t, tokenfactorytypes.DefaultParams().String(), app.TokenFactoryKeeper.GetParams(ctx).String())
+	require.Equal(t, uint64(1), app.UpgradeKeeper.GetModuleVersionMap(ctx)[tokenfactorytypes.ModuleName])
+}
+
+func TestStargateQueriesWhitelisted(t *testing.T) {
+	account := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	app := nolusapp.SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{account})
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+	paramSpace := app.GetSubspace(wasmbinding.ParamsSubspace)
+
+	// whitelisted at genesis
+	require.Equal(t, wasmbinding.DefaultStargateQueries(), wasmbinding.GetStargateQueries(ctx, paramSpace))
+
+	// the state of a chain running v0.1.44, without the whitelist
+	paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	paramsStore.Delete(append([]byte(wasmbinding.ParamsSubspace+"/"), wasmbinding.KeyStargateQueries...))
+	require.Empty(t, wasmbinding.GetStargateQueries(ctx, paramSpace))
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v0.1.45", Height: ctx.BlockHeight()})
+
+	require.Equal(t, wasmbinding.DefaultStargateQueries(), wasmbinding.GetStargateQueries(ctx, paramSpace))
+}
+
+func TestUpgradeV1_46AddsPacketForward(t *testing.T) {
+	account := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	app := nolusapp.SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{account})
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	// the version map of a chain running v0.1.45
+	upgradeStore := ctx.KVStore(app.GetKey(upgradetypes.StoreKey))
+	upgradeStore.Delete(append([]byte{upgradetypes.VersionMapByte}, []byte(packetforwardtypes.ModuleName)...))
+	require.NotContains(t, app.UpgradeKeeper.GetModuleVersionMap(ctx), packetforwardtypes.ModuleName)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v0.1.46", Height: ctx.BlockHeight()})
+
+	require.Equal(t, uint64(1), app.UpgradeKeeper.GetModuleVersionMap(ctx)[packetforwardtypes.ModuleName])
+	require.Empty(t, app.PacketForwardKeeper.GetAllInFlightPackets(ctx))
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// wasmConfigTemplate is the [wasm] section of the app.toml template of cosmoscmd.
+const wasmConfigTemplate = `
+[wasm]
+# This is the maximum sdk gas (wasm and storage) that we allow for any x/wasm "smart" queries
+query_gas_limit = 300000
+# This is the number of wasm vm instances we keep cached in memory for speed-up
+# Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
+lru_size = 0`
+
+// WithAppConfig makes the root command write app.toml, if it does not exist yet, from the template
+// of cosmoscmd extended with the [ante] section, before the configuration is read.
+func WithAppConfig(rootCmd *cobra.Command) {
+	preRunE := rootCmd.PersistentPreRunE
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := writeAppConfig(cmd); err != nil {
+			return err
+		}
+
+		return preRunE(cmd, args)
+	}
+}
+
+func writeAppConfig(cmd *cobra.Command) error {
+	// resolve the home the same way the server does, i.e. from the flags or the environment
+	executableName, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	v := viper.New()
+	if err = v.BindPFlags(cmd.Flags()); err != nil {
+		return err
+	}
+	if err = v.BindPFlags(cmd.PersistentFlags()); err != nil {
+		return err
+	}
+	v.SetEnvPrefix(path.Base(executableName))
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	v.AutomaticEnv()
+
+	home := v.GetString(flags.FlagHome)
+	if home == "" {
+		return nil
+	}
+
+	configPath := filepath.Join(home, "config")
+	appCfgFilePath := filepath.Join(configPath, "app.toml")
+	if _, err = os.Stat(appCfgFilePath); !os.IsNotExist(err) {
+		return err
+	}
+
+	if err = os.MkdirAll(configPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	// the same defaults as cosmoscmd
+	srvCfg := serverconfig.DefaultConfig()
+	srvCfg.MinGasPrices = "0stake"
+
+	serverconfig.SetConfigTemplate(serverconfig.DefaultConfigTemplate + wasmConfigTemplate + "\n" + app.AnteConfigTemplate)
+	serverconfig.WriteConfigFile(appCfgFilePath, srvCfg)
+
+	return nil
+}
@@ -35,6 +35,7 @@ func GetWasmCmdOptions() []cosmoscmd.Option {
 	options = append(options,
 		cosmoscmd.CustomizeStartCmd(func(startCmd *cobra.Command) {
 			wasm.AddModuleInitFlags(startCmd)
+			app.AddAnteFlags(startCmd)
 		}),
 		cosmoscmd.AddSubCmd(AddGenesisWasmMsgCmd(app.DefaultNodeHome)),
 	)
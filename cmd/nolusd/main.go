@@ -23,6 +23,7 @@ func main() {
 		app.New,
 		cmdOptions...,
 	)
+	WithAppConfig(rootCmd)
 
 	if err := svrcmd.Execute(rootCmd, app.DefaultNodeHome); err != nil {
 		os.Exit(1)
@@ -0,0 +1,29 @@
+package contractmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	contractmanagerkeeper "github.com/neutron-org/neutron/x/contractmanager/keeper"
+	contractmanagertypes "github.com/neutron-org/neutron/x/contractmanager/types"
+)
+
+// Keeper extends the contract manager keeper of neutron with the removal of the failures it records,
+// which it has no way to do, e.g. once the failed callbacks are resubmitted.
+type Keeper struct {
+	contractmanagerkeeper.Keeper
+
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper wraps the contract manager keeper of neutron, given the store key it was created with.
+func NewKeeper(k contractmanagerkeeper.Keeper, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		Keeper:   k,
+		storeKey: storeKey,
+	}
+}
+
+// RemoveContractFailure removes a failure of the contract.
+func (k Keeper) RemoveContractFailure(ctx sdk.Context, address string, failureID uint64) {
+	ctx.KVStore(k.storeKey).Delete(contractmanagertypes.GetFailureKey(address, failureID))
+}
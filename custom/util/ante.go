@@ -0,0 +1,19 @@
+package util
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// ChainWrappedAnteDecorators chains the decorators wrapped by an ante decorator, continuing with
+// the rest of the outer ante handler chain, i.e. next, once all of them pass.
+func ChainWrappedAnteDecorators(next sdk.AnteHandler, decorators ...sdk.AnteDecorator) sdk.AnteHandler {
+	chain := append(append([]sdk.AnteDecorator{}, decorators...), nextDecorator{next: next})
+	return sdk.ChainAnteDecorators(chain...)
+}
+
+// nextDecorator continues with the rest of the outer ante handler chain once the wrapped decorators pass.
+type nextDecorator struct {
+	next sdk.AnteHandler
+}
+
+func (nd nextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return nd.next(ctx, tx, simulate)
+}
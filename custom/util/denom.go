@@ -0,0 +1,23 @@
+package util
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// SentDenom returns the Nolus bank denom of the tokens of an outgoing ICS-20 packet.
+func SentDenom(data transfertypes.FungibleTokenPacketData) string {
+	return transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+}
+
+// ReceivedDenom returns the Nolus bank denom of the tokens of an incoming ICS-20 packet. The tokens returning
+// to Nolus are unescrowed in their original denom, the others are minted as vouchers.
+func ReceivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		prefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(data.Denom[len(prefix):]).IBCDenom()
+	}
+
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/Nolus-Protocol/nolus-core/custom/contractmanager"
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	contractmanagerkeeper "github.com/neutron-org/neutron/x/contractmanager/keeper"
+	contractmanagertypes "github.com/neutron-org/neutron/x/contractmanager/types"
+)
+
+// ContractFailuresWasmKeeper is the wasm keeper of both the contract manager and the contractfailures keeper.
+type ContractFailuresWasmKeeper interface {
+	types.WasmKeeper
+	contractmanagertypes.WasmKeeper
+}
+
+func ContractFailuresKeeper(t testing.TB, wasmKeeper ContractFailuresWasmKeeper) (*keeper.Keeper, *contractmanagerkeeper.Keeper, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	contractManagerStoreKey := sdk.NewKVStoreKey(contractmanagertypes.StoreKey)
+	contractManagerMemStoreKey := storetypes.NewMemoryStoreKey(contractmanagertypes.MemStoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(typesparams.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(typesparams.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(contractManagerStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(contractManagerMemStoreKey, sdk.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsTStoreKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	contractManagerKeeper := contractmanagerkeeper.NewKeeper(
+		cdc,
+		contractManagerStoreKey,
+		contractManagerMemStoreKey,
+		typesparams.NewSubspace(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, contractmanagertypes.ModuleName),
+		wasmKeeper,
+	)
+	k := keeper.NewKeeper(
+		cdc,
+		storeKey,
+		typesparams.NewSubspace(cdc, types.Amino, paramsStoreKey, paramsTStoreKey, types.ModuleName),
+		contractmanager.NewKeeper(*contractManagerKeeper, contractManagerStoreKey),
+		wasmKeeper,
+	)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	// Initialize params
+	k.SetParams(ctx, types.DefaultParams())
+
+	return k, contractManagerKeeper, ctx
+}
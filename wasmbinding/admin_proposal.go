@@ -0,0 +1,108 @@
+package wasmbinding
+
+import (
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+)
+
+// KeyAdminContracts is the key of the governance approved admin contracts, typically DAOs,
+// allowed to submit governance proposals through the SubmitAdminProposal custom message.
+var KeyAdminContracts = []byte("AdminContracts")
+
+// GetAdminProposalContent returns the governance proposal content of the admin proposal.
+// Exactly one kind of proposal must be set.
+func GetAdminProposalContent(proposal bindings.AdminProposal) (govtypes.Content, error) {
+	var contents []govtypes.Content
+
+	if p := proposal.ParamChangeProposal; p != nil {
+		contents = append(contents, paramproposal.NewParameterChangeProposal(p.Title, p.Description, p.ParamChanges))
+	}
+	if p := proposal.SoftwareUpgradeProposal; p != nil {
+		contents = append(contents, upgradetypes.NewSoftwareUpgradeProposal(p.Title, p.Description, upgradetypes.Plan{
+			Name:   p.Plan.Name,
+			Height: p.Plan.Height,
+			Info:   p.Plan.Info,
+		}))
+	}
+	if p := proposal.CancelSoftwareUpgradeProposal; p != nil {
+		contents = append(contents, upgradetypes.NewCancelSoftwareUpgradeProposal(p.Title, p.Description))
+	}
+	if p := proposal.ClientUpdateProposal; p != nil {
+		contents = append(contents, ibcclienttypes.NewClientUpdateProposal(p.Title, p.Description, p.SubjectClientId, p.SubstituteClientId))
+	}
+	if p := proposal.CommunityPoolSpendProposal; p != nil {
+		recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+		contents = append(contents, distrtypes.NewCommunityPoolSpendProposal(p.Title, p.Description, recipient, p.Amount))
+	}
+	if p := proposal.PinCodesProposal; p != nil {
+		contents = append(contents, &wasmtypes.PinCodesProposal{Title: p.Title, Description: p.Description, CodeIDs: p.CodeIDs})
+	}
+	if p := proposal.UnpinCodesProposal; p != nil {
+		contents = append(contents, &wasmtypes.UnpinCodesProposal{Title: p.Title, Description: p.Description, CodeIDs: p.CodeIDs})
+	}
+	if p := proposal.MigrateContractProposal; p != nil {
+		contents = append(contents, &wasmtypes.MigrateContractProposal{
+			Title:       p.Title,
+			Description: p.Description,
+			Contract:    p.Contract,
+			CodeID:      p.CodeID,
+			Msg:         p.Msg,
+		})
+	}
+
+	if len(contents) != 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "admin proposal must have exactly one proposal kind, got %d", len(contents))
+	}
+
+	return contents[0], nil
+}
+
+// GetAdminContracts returns the governance approved admin contracts.
+func (m *CustomMessenger) GetAdminContracts(ctx sdk.Context) (res []string) {
+	m.paramSpace.GetIfExists(ctx, KeyAdminContracts, &res)
+	return
+}
+
+func (m *CustomMessenger) isAdminContract(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+	for _, admin := range m.GetAdminContracts(ctx) {
+		if admin == contractAddr.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateAdminContracts(v interface{}) error {
+	contracts, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool, len(contracts))
+	for _, contract := range contracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid admin contract address %q: %w", contract, err)
+		}
+
+		if seen[contract] {
+			return fmt.Errorf("duplicate admin contract: %s", contract)
+		}
+		seen[contract] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+)
+
+// TestSubmitAdminProposal runs on a nolus app, unlike the tests in wasmbinding/test which run on a neutron app,
+// since the bech32 prefix of the cached addresses cannot be switched within the same test binary.
+func TestSubmitAdminProposal(t *testing.T) {
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	app := nolusapp.SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{authtypes.NewBaseAccountWithAddress(admin)},
+		banktypes.Balance{Address: admin.String(), Coins: deposit})
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+	paramSpace := app.GetSubspace(wasmbinding.ParamsSubspace)
+	messenger := wasmbinding.CustomMessageDecorator(&app.InterchainTxsKeeper, app.ICAControllerKeeper, app.IBCKeeper.ChannelKeeper, &app.InterchainQueriesKeeper, app.TransferKeeper, &app.ContractTransfersKeeper, &app.ContractFailuresKeeper,
+		paramSpace, &app.GovKeeper, &app.TokenFactoryKeeper, &app.TaxKeeper)(nil).(*wasmbinding.CustomMessenger)
+
+	paramSpace.Set(ctx, wasmbinding.KeyAdminContracts, []string{admin.String()})
+	require.Equal(t, []string{admin.String()}, messenger.GetAdminContracts(ctx))
+
+	msg, err := json.Marshal(bindings.NeutronMsg{
+		SubmitAdminProposal: &bindings.SubmitAdminProposal{
+			AdminProposal: bindings.AdminProposal{
+				ParamChangeProposal: &bindings.ParamChangeProposal{
+					Title:        "title",
+					Description:  "description",
+					ParamChanges: []paramproposal.ParamChange{paramproposal.NewParamChange("tax", "FeeRate", `30`)},
+				},
+			},
+			InitialDeposit: deposit,
+		},
+	})
+	require.NoError(t, err)
+
+	_, _, err = messenger.DispatchMsg(ctx, other, "", types.CosmosMsg{Custom: msg})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	events, data, err := messenger.DispatchMsg(ctx, admin, "", types.CosmosMsg{Custom: msg})
+	require.NoError(t, err)
+	require.Nil(t, events)
+	require.Equal(t, [][]byte{[]byte(`{"proposal_id":1}`)}, data)
+
+	proposal, found := app.GovKeeper.GetProposal(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, govtypes.StatusDepositPeriod, proposal.Status)
+	require.Equal(t, "title", proposal.GetContent().GetTitle())
+	require.Equal(t, deposit, proposal.TotalDeposit)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, admin).IsZero())
+}
@@ -2,6 +2,9 @@
 package bindings
 
 import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramChange "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	feetypes "github.com/neutron-org/neutron/x/feerefunder/types"
@@ -19,12 +22,20 @@ type ProtobufAny struct {
 // Follow https://github.com/neutron-org/neutron-contracts/tree/main/packages/bindings/src/msg.rs
 // for more information.
 type NeutronMsg struct {
-	SubmitTx                  *SubmitTx                         `json:"submit_tx,omitempty"`
-	RegisterInterchainAccount *RegisterInterchainAccount        `json:"register_interchain_account,omitempty"`
-	RegisterInterchainQuery   *RegisterInterchainQuery          `json:"register_interchain_query,omitempty"`
-	UpdateInterchainQuery     *UpdateInterchainQuery            `json:"update_interchain_query,omitempty"`
-	RemoveInterchainQuery     *RemoveInterchainQuery            `json:"remove_interchain_query,omitempty"`
-	IBCTransfer               *transferwrappertypes.MsgTransfer `json:"ibc_transfer,omitempty"`
+	SubmitTx                  *SubmitTx                  `json:"submit_tx,omitempty"`
+	RegisterInterchainAccount *RegisterInterchainAccount `json:"register_interchain_account,omitempty"`
+	RegisterInterchainQuery   *RegisterInterchainQuery   `json:"register_interchain_query,omitempty"`
+	UpdateInterchainQuery     *UpdateInterchainQuery     `json:"update_interchain_query,omitempty"`
+	RemoveInterchainQuery     *RemoveInterchainQuery     `json:"remove_interchain_query,omitempty"`
+	IBCTransfer               *IBCTransfer               `json:"ibc_transfer,omitempty"`
+	SubmitAdminProposal       *SubmitAdminProposal       `json:"submit_admin_proposal,omitempty"`
+	CreateDenom               *CreateDenom               `json:"create_denom,omitempty"`
+	Mint                      *MintTokens                `json:"mint,omitempty"`
+	Burn                      *BurnTokens                `json:"burn,omitempty"`
+	ChangeAdmin               *ChangeAdmin               `json:"change_admin,omitempty"`
+	SetMetadata               *SetMetadata               `json:"set_metadata,omitempty"`
+	ResubmitFailure           *ResubmitFailure           `json:"resubmit_failure,omitempty"`
+	Batch                     *Batch                     `json:"batch,omitempty"`
 }
 
 // SubmitTx submits interchain transaction on a remote chain.
@@ -49,10 +60,21 @@ type SubmitTxResponse struct {
 type RegisterInterchainAccount struct {
 	ConnectionId        string `json:"connection_id"`
 	InterchainAccountId string `json:"interchain_account_id"`
+	// Ordering is the ordering of the channel, ORDER_ORDERED if empty, the only one interchain accounts support.
+	Ordering string `json:"ordering,omitempty"`
+	// Version is the ICS-27 metadata of the channel, the default one for the connection if empty.
+	Version string `json:"version,omitempty"`
+	// FeeEnabled requests a channel with the ICS-29 fee middleware, which is not enabled.
+	FeeEnabled bool `json:"fee_enabled,omitempty"`
 }
 
 // RegisterInterchainAccountResponse holds response for RegisterInterchainAccount.
-type RegisterInterchainAccountResponse struct{}
+type RegisterInterchainAccountResponse struct {
+	// PortId is the controller port of the interchain account.
+	PortId string `json:"port_id"`
+	// ChannelId is the channel opened for the interchain account, active once the handshake completes.
+	ChannelId string `json:"channel_id"`
+}
 
 // RegisterInterchainQuery creates a query for remote chain.
 type RegisterInterchainQuery struct {
@@ -63,6 +85,46 @@ type RegisterInterchainQuery struct {
 	UpdatePeriod       uint64            `json:"update_period"`
 }
 
+// IBCTransfer transfers tokens to a remote chain. The optional callback id is echoed back
+// to the contract once the transfer is acknowledged or timed out.
+type IBCTransfer struct {
+	transferwrappertypes.MsgTransfer
+	CallbackId string `json:"callback_id,omitempty"`
+}
+
+// IBCTransferResponse holds response from IBCTransfer.
+type IBCTransferResponse struct {
+	// SequenceId is a channel's sequence_id for outgoing ibc packet. Unique per a channel.
+	SequenceId uint64 `json:"sequence_id"`
+	// Channel is a src channel on neutron side transaction was submitted from
+	Channel    string `json:"channel"`
+	CallbackId string `json:"callback_id,omitempty"`
+}
+
+// SubmitAdminProposal submits a governance proposal on behalf of a governance approved admin contract.
+type SubmitAdminProposal struct {
+	AdminProposal AdminProposal `json:"admin_proposal"`
+	// InitialDeposit is paid by the admin contract.
+	InitialDeposit sdk.Coins `json:"initial_deposit"`
+}
+
+// SubmitAdminProposalResponse holds response from SubmitAdminProposal.
+type SubmitAdminProposalResponse struct {
+	ProposalId uint64 `json:"proposal_id"`
+}
+
+// AdminProposal is used like a sum type to hold one of the governance proposals an admin contract can submit.
+type AdminProposal struct {
+	ParamChangeProposal           *ParamChangeProposal           `json:"param_change_proposal,omitempty"`
+	SoftwareUpgradeProposal       *SoftwareUpgradeProposal       `json:"software_upgrade_proposal,omitempty"`
+	CancelSoftwareUpgradeProposal *CancelSoftwareUpgradeProposal `json:"cancel_software_upgrade_proposal,omitempty"`
+	ClientUpdateProposal          *ClientUpdateProposal          `json:"client_update_proposal,omitempty"`
+	CommunityPoolSpendProposal    *CommunityPoolSpendProposal    `json:"community_pool_spend_proposal,omitempty"`
+	PinCodesProposal              *PinCodesProposal              `json:"pin_codes_proposal,omitempty"`
+	UnpinCodesProposal            *UnpinCodesProposal            `json:"unpin_codes_proposal,omitempty"`
+	MigrateContractProposal       *MigrateContractProposal       `json:"migrate_contract_proposal,omitempty"`
+}
+
 type ParamChangeProposal struct {
 	Title        string                    `json:"title"`
 	Description  string                    `json:"description"`
@@ -86,6 +148,41 @@ type Plan struct {
 	Info   string `json:"info"`
 }
 
+type ClientUpdateProposal struct {
+	Title              string `json:"title"`
+	Description        string `json:"description"`
+	SubjectClientId    string `json:"subject_client_id"`
+	SubstituteClientId string `json:"substitute_client_id"`
+}
+
+type CommunityPoolSpendProposal struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Recipient   string    `json:"recipient"`
+	Amount      sdk.Coins `json:"amount"`
+}
+
+type PinCodesProposal struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	CodeIDs     []uint64 `json:"code_ids"`
+}
+
+type UnpinCodesProposal struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	CodeIDs     []uint64 `json:"code_ids"`
+}
+
+type MigrateContractProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Contract    string `json:"contract"`
+	CodeID      uint64 `json:"code_id"`
+	// Msg is the JSON encoded migrate message of the contract.
+	Msg []byte `json:"msg"`
+}
+
 // RegisterInterchainQueryResponse holds response for RegisterInterchainQuery.
 type RegisterInterchainQueryResponse struct {
 	Id uint64 `json:"id"`
@@ -105,3 +202,72 @@ type UpdateInterchainQuery struct {
 }
 
 type UpdateInterchainQueryResponse struct{}
+
+// CreateDenom creates the factory denom factory/{contract}/{subdenom} administered by the contract.
+type CreateDenom struct {
+	Subdenom string `json:"subdenom"`
+}
+
+// CreateDenomResponse holds response for CreateDenom.
+type CreateDenomResponse struct {
+	NewTokenDenom string `json:"new_token_denom"`
+}
+
+// MintTokens mints tokens of a factory denom administered by the contract.
+type MintTokens struct {
+	Denom  string  `json:"denom"`
+	Amount sdk.Int `json:"amount"`
+	// MintToAddress receives the minted tokens, the contract if empty.
+	MintToAddress string `json:"mint_to_address"`
+}
+
+// BurnTokens burns tokens of a factory denom administered by the contract, from the balance of the contract.
+type BurnTokens struct {
+	Denom  string  `json:"denom"`
+	Amount sdk.Int `json:"amount"`
+}
+
+// ChangeAdmin hands the administration of a factory denom over to another account, or renounces it if empty.
+type ChangeAdmin struct {
+	Denom           string `json:"denom"`
+	NewAdminAddress string `json:"new_admin_address"`
+}
+
+// SetMetadata sets the bank metadata of a factory denom administered by the contract.
+type SetMetadata struct {
+	Metadata Metadata `json:"metadata"`
+}
+
+// Metadata is the bank metadata of a denom.
+type Metadata struct {
+	Description string `json:"description"`
+	// DenomUnits are the units of the denom, the first one being the base denom with exponent 0.
+	DenomUnits []DenomUnit `json:"denom_units"`
+	// Base is the factory denom.
+	Base    string `json:"base"`
+	Display string `json:"display"`
+	Name    string `json:"name"`
+	Symbol  string `json:"symbol"`
+}
+
+// DenomUnit is a unit of a denom, worth 10^Exponent base units.
+type DenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases"`
+}
+
+// ResubmitFailure calls the contract again with the sudo message of one of its failed callbacks.
+type ResubmitFailure struct {
+	FailureId uint64 `json:"failure_id"`
+}
+
+// Batch dispatches the custom messages in order, all of them or none if any fails. Batches cannot be nested.
+type Batch struct {
+	Msgs []NeutronMsg `json:"msgs"`
+}
+
+// BatchResponse holds the responses of the messages of a Batch, in the same order.
+type BatchResponse struct {
+	Responses []json.RawMessage `json:"responses"`
+}
@@ -19,12 +19,29 @@ type NeutronQuery struct {
 	RegisteredInterchainQueries *QueryRegisteredQueriesRequest `json:"registered_interchain_queries,omitempty"`
 	// RegisteredInterchainQuery
 	RegisteredInterchainQuery *QueryRegisteredQueryRequest `json:"registered_interchain_query,omitempty"`
+	// Current state of the minter
+	MintState *QueryMintStateRequest `json:"mint_state,omitempty"`
+	// Parameters of the mint module
+	MintParams *QueryMintParamsRequest `json:"mint_params,omitempty"`
+	// Tokens to be minted in the next 12 months
+	AnnualInflation *QueryAnnualInflationRequest `json:"annual_inflation,omitempty"`
+	// Tokens to be minted in the specified number of months
+	MintProjection *QueryMintProjectionRequest `json:"mint_projection,omitempty"`
+	// Parameters of the tax module
+	TaxParams *QueryTaxParamsRequest `json:"tax_params,omitempty"`
+	// Tax collected in the current block
+	TaxCollected *QueryTaxCollectedRequest `json:"tax_collected,omitempty"`
+	// Outcome of an IBC transfer sent by a contract
+	IbcTransferStatus *QueryIbcTransferStatusRequest `json:"ibc_transfer_status,omitempty"`
 }
 
 /* Requests */
 
 type QueryRegisteredQueryResultRequest struct {
 	QueryID uint64 `json:"query_id,omitempty"`
+	// MaxAgeBlocks, if set, is the number of blocks the result may have been submitted before the current one.
+	// An older result is rejected with ErrStaleQueryResult.
+	MaxAgeBlocks *uint64 `json:"max_age_blocks,omitempty"`
 }
 
 type QueryInterchainAccountAddressRequest struct {
@@ -37,15 +54,39 @@ type QueryInterchainAccountAddressRequest struct {
 }
 
 type QueryRegisteredQueriesRequest struct {
-	Owners       []string           `json:"owners,omitempty"`
-	ConnectionID string             `json:"connection_id,omitempty"`
-	Pagination   *query.PageRequest `json:"pagination,omitempty"`
+	Owners       []string `json:"owners,omitempty"`
+	ConnectionID string   `json:"connection_id,omitempty"`
+	// QueryType, if set, keeps only the queries of the type, 'kv' or 'tx'.
+	QueryType string `json:"query_type,omitempty"`
+	// Stale, if set, keeps only the queries whose result was not (true) or was (false) submitted
+	// within their update period.
+	Stale      *bool              `json:"stale,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
 }
 
 type QueryRegisteredQueryRequest struct {
 	QueryID uint64 `json:"query_id,omitempty"`
 }
 
+type QueryMintStateRequest struct{}
+
+type QueryMintParamsRequest struct{}
+
+type QueryAnnualInflationRequest struct{}
+
+type QueryMintProjectionRequest struct {
+	Months uint32 `json:"months"`
+}
+
+type QueryTaxParamsRequest struct{}
+
+type QueryTaxCollectedRequest struct{}
+
+type QueryIbcTransferStatusRequest struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
+
 /* Responses */
 
 type QueryRegisteredQueryResponse struct {
@@ -54,6 +95,8 @@ type QueryRegisteredQueryResponse struct {
 
 type QueryRegisteredQueriesResponse struct {
 	RegisteredQueries []RegisteredQuery `json:"registered_queries"`
+	// The next key to continue the listing from, and the total if requested.
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
 }
 
 type RegisteredQuery struct {
@@ -107,6 +150,11 @@ type QueryInterchainAccountAddressResponse struct {
 
 type QueryRegisteredQueryResultResponse struct {
 	Result *QueryResult `json:"result,omitempty"`
+	// LastSubmittedResultLocalHeight is the Nolus height the result was submitted at.
+	LastSubmittedResultLocalHeight uint64 `json:"last_submitted_result_local_height"`
+	// LastSubmittedResultLocalTime is the Nolus block time, in nanoseconds since the epoch, the result was submitted at.
+	// It is omitted once the header of that block is no longer kept.
+	LastSubmittedResultLocalTime uint64 `json:"last_submitted_result_local_time,string,omitempty"`
 }
 
 type QueryResult struct {
@@ -141,3 +189,107 @@ func (sv StorageValue) MarshalJSON() ([]byte, error) {
 
 	return json.Marshal(a)
 }
+
+type QueryMintStateResponse struct {
+	// The normalized time passed since the minting start, in months.
+	NormTimePassed sdktypes.Dec `json:"norm_time_passed"`
+	// The amount of tokens minted so far.
+	TotalMinted sdktypes.Uint `json:"total_minted"`
+}
+
+type QueryMintParamsResponse struct {
+	// The denom of the minted tokens.
+	MintDenom string `json:"mint_denom"`
+	// The maximum time between two blocks for which tokens are minted.
+	MaxMintableNanoseconds sdktypes.Uint `json:"max_mintable_nanoseconds"`
+}
+
+type QueryAnnualInflationResponse struct {
+	// The amount of tokens to be minted in the next 12 months.
+	AnnualInflation sdktypes.Uint `json:"annual_inflation"`
+}
+
+type QueryMintProjectionResponse struct {
+	Months uint32 `json:"months"`
+	// The amount of tokens to be minted in the requested months.
+	Minted sdktypes.Uint `json:"minted"`
+}
+
+type QueryTaxParamsResponse struct {
+	FeeRate                    int32             `json:"fee_rate"`
+	ContractAddress            string            `json:"contract_address"`
+	BaseDenom                  string            `json:"base_denom"`
+	MinGasPrice                sdktypes.Dec      `json:"min_gas_price"`
+	FeeDenomMinGasPrices       sdktypes.DecCoins `json:"fee_denom_min_gas_prices"`
+	MaxBaseFee                 sdktypes.Dec      `json:"max_base_fee"`
+	TargetBlockGas             uint64            `json:"target_block_gas"`
+	BaseFeeChangeDenominator   uint32            `json:"base_fee_change_denominator"`
+	UnusedGasRefundRate        sdktypes.Dec      `json:"unused_gas_refund_rate"`
+	DisallowedMsgTypes         []string          `json:"disallowed_msg_types"`
+	BypassMinFeeMsgTypes       []string          `json:"bypass_min_fee_msg_types"`
+	MaxBypassMinFeeMsgGasUsage uint64            `json:"max_bypass_min_fee_msg_gas_usage"`
+	PriceFeeders               []string          `json:"price_feeders"`
+	PriceFeedContracts         []string          `json:"price_feed_contracts"`
+}
+
+func (tp QueryTaxParamsResponse) MarshalJSON() ([]byte, error) {
+	type AliasTP QueryTaxParamsResponse
+
+	a := struct {
+		AliasTP
+	}{
+		AliasTP: (AliasTP)(tp),
+	}
+
+	// We want the lists be as empty arrays in Json ('[]'), not 'null'
+	// It's easier to work with on smart-contracts side
+	if a.FeeDenomMinGasPrices == nil {
+		a.FeeDenomMinGasPrices = make(sdktypes.DecCoins, 0)
+	}
+	if a.DisallowedMsgTypes == nil {
+		a.DisallowedMsgTypes = make([]string, 0)
+	}
+	if a.BypassMinFeeMsgTypes == nil {
+		a.BypassMinFeeMsgTypes = make([]string, 0)
+	}
+	if a.PriceFeeders == nil {
+		a.PriceFeeders = make([]string, 0)
+	}
+	if a.PriceFeedContracts == nil {
+		a.PriceFeedContracts = make([]string, 0)
+	}
+
+	return json.Marshal(a)
+}
+
+type QueryTaxCollectedResponse struct {
+	// The tax collected in the current block.
+	Collected sdktypes.Coins `json:"collected"`
+}
+
+func (tc QueryTaxCollectedResponse) MarshalJSON() ([]byte, error) {
+	type AliasTC QueryTaxCollectedResponse
+
+	a := struct {
+		AliasTC
+	}{
+		AliasTC: (AliasTC)(tc),
+	}
+
+	// We want the collected coins be as empty array in Json ('[]'), not 'null'
+	// It's easier to work with on smart-contracts side
+	if a.Collected == nil {
+		a.Collected = make(sdktypes.Coins, 0)
+	}
+
+	return json.Marshal(a)
+}
+
+type QueryIbcTransferStatusResponse struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	// The identifier supplied by the contract with the transfer.
+	CallbackId string `json:"callback_id"`
+	// One of 'pending', 'acknowledged', 'error' or 'timeout'.
+	Status string `json:"status"`
+}
@@ -0,0 +1,22 @@
+package wasmbinding
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+)
+
+// resubmitFailure calls the contract again with one of its failed callbacks, removing the failure if it succeeds.
+func (m *CustomMessenger) resubmitFailure(ctx sdk.Context, contractAddr sdk.AccAddress, resubmitFailure *bindings.ResubmitFailure) ([]sdk.Event, [][]byte, error) {
+	if err := m.contractFailuresKeeper.Resubmit(ctx, contractAddr, contractAddr, resubmitFailure.FailureId); err != nil {
+		ctx.Logger().Debug("ResubmitFailure: failed to resubmit the failed callback",
+			"from_address", contractAddr.String(),
+			"msg", resubmitFailure,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to resubmit the failed callback")
+	}
+
+	return nil, nil, nil
+}
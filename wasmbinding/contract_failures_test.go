@@ -0,0 +1,42 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	contractfailurestypes "github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+)
+
+func TestResubmitFailure(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	app.ContractFailuresKeeper.SetParams(ctx, contractfailurestypes.DefaultParams())
+	messenger := wasmbinding.CustomMessageDecorator(&app.InterchainTxsKeeper, app.ICAControllerKeeper, app.IBCKeeper.ChannelKeeper, &app.InterchainQueriesKeeper, app.TransferKeeper, &app.ContractTransfersKeeper, &app.ContractFailuresKeeper,
+		app.GetSubspace(wasmbinding.ParamsSubspace), &app.GovKeeper, &app.TokenFactoryKeeper, &app.TaxKeeper)(nil).(*wasmbinding.CustomMessenger)
+
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	dispatch := func(failureID uint64) error {
+		bz, err := json.Marshal(bindings.NeutronMsg{ResubmitFailure: &bindings.ResubmitFailure{FailureId: failureID}})
+		require.NoError(t, err)
+
+		_, _, err = messenger.DispatchMsg(ctx, contract, "", types.CosmosMsg{Custom: bz})
+		return err
+	}
+
+	require.ErrorIs(t, dispatch(0), contractfailurestypes.ErrFailureNotFound)
+
+	// the failure is kept as the callback fails again
+	failedSudo := contractfailurestypes.FailedSudo{Address: contract.String(), FailureId: 0, Msg: []byte(`{"timeout":{}}`)}
+	app.ContractFailuresKeeper.SetFailedSudo(ctx, failedSudo)
+	require.ErrorIs(t, dispatch(0), contractfailurestypes.ErrResubmitFailed)
+	got, found := app.ContractFailuresKeeper.GetFailedSudo(ctx, contract, 0)
+	require.True(t, found)
+	require.Equal(t, failedSudo, got)
+}
@@ -20,9 +20,7 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 		switch {
 		case contractQuery.InterchainQueryResult != nil:
-			queryID := contractQuery.InterchainQueryResult.QueryID
-
-			response, err := qp.GetInterchainQueryResult(ctx, queryID)
+			response, err := qp.GetInterchainQueryResult(ctx, contractQuery.InterchainQueryResult)
 			if err != nil {
 				return nil, sdkerrors.Wrapf(err, "failed to get interchain query result: %v", err)
 			}
@@ -69,6 +67,60 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 				return nil, sdkerrors.Wrapf(err, "failed to marshal interchain account query response: %v", err)
 			}
 
+			return bz, nil
+		case contractQuery.MintState != nil:
+			bz, err := json.Marshal(qp.GetMintState(ctx))
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal mint state query response: %v", err)
+			}
+
+			return bz, nil
+		case contractQuery.MintParams != nil:
+			bz, err := json.Marshal(qp.GetMintParams(ctx))
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal mint params query response: %v", err)
+			}
+
+			return bz, nil
+		case contractQuery.AnnualInflation != nil:
+			bz, err := json.Marshal(qp.GetAnnualInflation(ctx))
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal annual inflation query response: %v", err)
+			}
+
+			return bz, nil
+		case contractQuery.MintProjection != nil:
+			bz, err := json.Marshal(qp.GetMintProjection(ctx, contractQuery.MintProjection))
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal mint projection query response: %v", err)
+			}
+
+			return bz, nil
+		case contractQuery.TaxParams != nil:
+			bz, err := json.Marshal(qp.GetTaxParams(ctx))
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal tax params query response: %v", err)
+			}
+
+			return bz, nil
+		case contractQuery.TaxCollected != nil:
+			bz, err := json.Marshal(qp.GetTaxCollected(ctx))
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal tax collected query response: %v", err)
+			}
+
+			return bz, nil
+		case contractQuery.IbcTransferStatus != nil:
+			transferStatus, err := qp.GetIbcTransferStatus(ctx, contractQuery.IbcTransferStatus)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to get ibc transfer status: %v", err)
+			}
+
+			bz, err := json.Marshal(transferStatus)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal ibc transfer status query response: %v", err)
+			}
+
 			return bz, nil
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown neutron query type"}
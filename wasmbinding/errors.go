@@ -0,0 +1,17 @@
+package wasmbinding
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codespace is the codespace of the errors the custom bindings return to the contracts.
+const Codespace = "wasmbinding"
+
+// wasmbinding sentinel errors.
+var (
+	ErrStaleQueryResult   = sdkerrors.Register(Codespace, 1, "interchain query result is too old")
+	ErrUnsupportedChannel = sdkerrors.Register(Codespace, 2, "unsupported interchain account channel")
+	ErrInvalidBatch       = sdkerrors.Register(Codespace, 3, "invalid custom messages batch")
+)
@@ -0,0 +1,118 @@
+package wasmbinding
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+)
+
+// ParamsSubspace is the name of the params subspace of the custom messages.
+const ParamsSubspace = "wasmbinding"
+
+var (
+	KeySubmitTxGas                   = []byte("SubmitTxGas")
+	KeySubmitTxMsgGas                = []byte("SubmitTxMsgGas")
+	KeyRegisterInterchainAccountGas  = []byte("RegisterInterchainAccountGas")
+	KeyRegisterInterchainQueryGas    = []byte("RegisterInterchainQueryGas")
+	KeyRegisterInterchainQueryKeyGas = []byte("RegisterInterchainQueryKeyGas")
+	KeyUpdateInterchainQueryGas      = []byte("UpdateInterchainQueryGas")
+	KeyUpdateInterchainQueryKeyGas   = []byte("UpdateInterchainQueryKeyGas")
+	KeyRemoveInterchainQueryGas      = []byte("RemoveInterchainQueryGas")
+	KeyIBCTransferGas                = []byte("IBCTransferGas")
+)
+
+// GasSchedule is the gas charged to a contract for a custom message before it is dispatched,
+// on top of the gas consumed by its execution. It accounts for the ongoing relayer work caused
+// by the interchain transactions and queries, so it grows with the relayed messages and query keys.
+type GasSchedule struct {
+	// SubmitTxGas is the flat gas cost of an interchain transaction.
+	SubmitTxGas uint64 `json:"submit_tx_gas" yaml:"submit_tx_gas"`
+	// SubmitTxMsgGas is the gas cost of every message of an interchain transaction.
+	SubmitTxMsgGas uint64 `json:"submit_tx_msg_gas" yaml:"submit_tx_msg_gas"`
+	// RegisterInterchainAccountGas is the flat gas cost of an interchain account registration.
+	RegisterInterchainAccountGas uint64 `json:"register_interchain_account_gas" yaml:"register_interchain_account_gas"`
+	// RegisterInterchainQueryGas is the flat gas cost of an interchain query registration.
+	RegisterInterchainQueryGas uint64 `json:"register_interchain_query_gas" yaml:"register_interchain_query_gas"`
+	// RegisterInterchainQueryKeyGas is the gas cost of every KV key of a registered interchain query.
+	RegisterInterchainQueryKeyGas uint64 `json:"register_interchain_query_key_gas" yaml:"register_interchain_query_key_gas"`
+	// UpdateInterchainQueryGas is the flat gas cost of an interchain query update.
+	UpdateInterchainQueryGas uint64 `json:"update_interchain_query_gas" yaml:"update_interchain_query_gas"`
+	// UpdateInterchainQueryKeyGas is the gas cost of every new KV key of an updated interchain query.
+	UpdateInterchainQueryKeyGas uint64 `json:"update_interchain_query_key_gas" yaml:"update_interchain_query_key_gas"`
+	// RemoveInterchainQueryGas is the flat gas cost of an interchain query removal.
+	RemoveInterchainQueryGas uint64 `json:"remove_interchain_query_gas" yaml:"remove_interchain_query_gas"`
+	// IBCTransferGas is the flat gas cost of an IBC transfer.
+	IBCTransferGas uint64 `json:"ibc_transfer_gas" yaml:"ibc_transfer_gas"`
+}
+
+var _ paramtypes.ParamSet = (*GasSchedule)(nil)
+
+// ParamKeyTable the param key table of the custom messages and queries, made of the gas schedule,
+// the admin contracts and the allowed stargate queries.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().
+		RegisterParamSet(&GasSchedule{}).
+		RegisterType(paramtypes.NewParamSetPair(KeyAdminContracts, &[]string{}, validateAdminContracts)).
+		RegisterType(paramtypes.NewParamSetPair(KeyStargateQueries, &[]string{}, validateStargateQueries))
+}
+
+// DefaultGasSchedule returns the gas schedule in force until it is changed by governance.
+// Only the relayed messages and query keys are charged by default.
+func DefaultGasSchedule() GasSchedule {
+	return GasSchedule{
+		SubmitTxMsgGas:                20_000,
+		RegisterInterchainQueryKeyGas: 20_000,
+		UpdateInterchainQueryKeyGas:   20_000,
+	}
+}
+
+// ParamSetPairs get the params.ParamSet.
+func (gs *GasSchedule) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySubmitTxGas, &gs.SubmitTxGas, validateGas),
+		paramtypes.NewParamSetPair(KeySubmitTxMsgGas, &gs.SubmitTxMsgGas, validateGas),
+		paramtypes.NewParamSetPair(KeyRegisterInterchainAccountGas, &gs.RegisterInterchainAccountGas, validateGas),
+		paramtypes.NewParamSetPair(KeyRegisterInterchainQueryGas, &gs.RegisterInterchainQueryGas, validateGas),
+		paramtypes.NewParamSetPair(KeyRegisterInterchainQueryKeyGas, &gs.RegisterInterchainQueryKeyGas, validateGas),
+		paramtypes.NewParamSetPair(KeyUpdateInterchainQueryGas, &gs.UpdateInterchainQueryGas, validateGas),
+		paramtypes.NewParamSetPair(KeyUpdateInterchainQueryKeyGas, &gs.UpdateInterchainQueryKeyGas, validateGas),
+		paramtypes.NewParamSetPair(KeyRemoveInterchainQueryGas, &gs.RemoveInterchainQueryGas, validateGas),
+		paramtypes.NewParamSetPair(KeyIBCTransferGas, &gs.IBCTransferGas, validateGas),
+	}
+}
+
+// GasCost returns the gas charged for the custom message before it is dispatched.
+func (gs GasSchedule) GasCost(msg bindings.NeutronMsg) uint64 {
+	switch {
+	case msg.SubmitTx != nil:
+		return gs.SubmitTxGas + gs.SubmitTxMsgGas*uint64(len(msg.SubmitTx.Msgs))
+	case msg.RegisterInterchainAccount != nil:
+		return gs.RegisterInterchainAccountGas
+	case msg.RegisterInterchainQuery != nil:
+		return gs.RegisterInterchainQueryGas + gs.RegisterInterchainQueryKeyGas*uint64(len(msg.RegisterInterchainQuery.Keys))
+	case msg.UpdateInterchainQuery != nil:
+		return gs.UpdateInterchainQueryGas + gs.UpdateInterchainQueryKeyGas*uint64(len(msg.UpdateInterchainQuery.NewKeys))
+	case msg.RemoveInterchainQuery != nil:
+		return gs.RemoveInterchainQueryGas
+	case msg.IBCTransfer != nil:
+		return gs.IBCTransferGas
+	case msg.Batch != nil:
+		var gas uint64
+		for _, batchMsg := range msg.Batch.Msgs {
+			gas += gs.GasCost(batchMsg)
+		}
+		return gas
+	default:
+		return 0
+	}
+}
+
+func validateGas(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -2,41 +2,94 @@ package wasmbinding
 
 import (
 	"encoding/json"
+	"errors"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 
 	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	contractfailureskeeper "github.com/Nolus-Protocol/nolus-core/x/contractfailures/keeper"
+	contracttransferskeeper "github.com/Nolus-Protocol/nolus-core/x/contracttransfers/keeper"
+	contracttransferstypes "github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+	tokenfactorykeeper "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
 
 	icqkeeper "github.com/neutron-org/neutron/x/interchainqueries/keeper"
 	icqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
 	ictxkeeper "github.com/neutron-org/neutron/x/interchaintxs/keeper"
 	ictxtypes "github.com/neutron-org/neutron/x/interchaintxs/types"
 	transferwrapperkeeper "github.com/neutron-org/neutron/x/transfer/keeper"
-	transferwrappertypes "github.com/neutron-org/neutron/x/transfer/types"
 )
 
-func CustomMessageDecorator(ictx *ictxkeeper.Keeper, icq *icqkeeper.Keeper, transferKeeper transferwrapperkeeper.KeeperTransferWrapper) func(messenger wasmkeeper.Messenger) wasmkeeper.Messenger {
+// ICAControllerKeeper defines the expected interchain accounts controller keeper registering the accounts
+// of the contracts with the version they request.
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error
+}
+
+// MsgFilter defines the expected keeper rejecting the messages of a governance disallowed type.
+type MsgFilter interface {
+	CheckMsgTypes(ctx sdk.Context, msgs []sdk.Msg) error
+}
+
+// ChannelKeeper defines the expected channel keeper telling the channel an interchain account is opened on.
+type ChannelKeeper interface {
+	GetNextChannelSequence(ctx sdk.Context) uint64
+}
+
+func CustomMessageDecorator(ictx *ictxkeeper.Keeper, icaController ICAControllerKeeper, channelKeeper ChannelKeeper, icq *icqkeeper.Keeper, transferKeeper transferwrapperkeeper.KeeperTransferWrapper, contractTransfers *contracttransferskeeper.Keeper, contractFailures *contractfailureskeeper.Keeper, paramSpace paramtypes.Subspace, gov *govkeeper.Keeper, tokenFactory *tokenfactorykeeper.Keeper, msgFilter MsgFilter) func(messenger wasmkeeper.Messenger) wasmkeeper.Messenger {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(ParamKeyTable())
+	}
+
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
-			Keeper:         *ictx,
-			Wrapped:        old,
-			Ictxmsgserver:  ictxkeeper.NewMsgServerImpl(*ictx),
-			Icqmsgserver:   icqkeeper.NewMsgServerImpl(*icq),
-			transferKeeper: transferKeeper,
+			Keeper:                  *ictx,
+			Wrapped:                 old,
+			Ictxmsgserver:           ictxkeeper.NewMsgServerImpl(*ictx),
+			icaControllerKeeper:     icaController,
+			channelKeeper:           channelKeeper,
+			Icqmsgserver:            icqkeeper.NewMsgServerImpl(*icq),
+			transferKeeper:          transferKeeper,
+			contractTransfersKeeper: contractTransfers,
+			contractFailuresKeeper:  contractFailures,
+			paramSpace:              paramSpace,
+			govKeeper:               gov,
+			tokenFactoryKeeper:      tokenFactory,
+			msgFilter:               msgFilter,
 		}
 	}
 }
 
 type CustomMessenger struct {
-	Keeper         ictxkeeper.Keeper
-	Wrapped        wasmkeeper.Messenger
-	Ictxmsgserver  ictxtypes.MsgServer
-	Icqmsgserver   icqtypes.MsgServer
-	transferKeeper transferwrapperkeeper.KeeperTransferWrapper
+	Keeper        ictxkeeper.Keeper
+	Wrapped       wasmkeeper.Messenger
+	Ictxmsgserver ictxtypes.MsgServer
+	// icaControllerKeeper registers the interchain accounts with the version requested by the contracts,
+	// which the interchain transactions module does not forward
+	icaControllerKeeper ICAControllerKeeper
+	channelKeeper       ChannelKeeper
+	Icqmsgserver        icqtypes.MsgServer
+	transferKeeper      transferwrapperkeeper.KeeperTransferWrapper
+	// contractTransfersKeeper records the outcome of the transfers sent by the contracts
+	contractTransfersKeeper *contracttransferskeeper.Keeper
+	// contractFailuresKeeper calls the contracts again with their failed callbacks
+	contractFailuresKeeper *contractfailureskeeper.Keeper
+	paramSpace             paramtypes.Subspace
+	// govKeeper is set once the app has created it, after the wasm keeper
+	govKeeper *govkeeper.Keeper
+	// tokenFactoryKeeper is set once the app has created it, after the tax keeper providing the treasury
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	// msgFilter rejects the custom messages of a disallowed type, the other ones are rejected by the message router
+	msgFilter MsgFilter
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -53,37 +106,202 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 			return nil, nil, sdkerrors.Wrap(err, "failed to decode incoming custom cosmos message")
 		}
 
-		if contractMsg.SubmitTx != nil {
-			return m.submitTx(ctx, contractAddr, contractMsg.SubmitTx)
-		}
-		if contractMsg.RegisterInterchainAccount != nil {
-			return m.registerInterchainAccount(ctx, contractAddr, contractMsg.RegisterInterchainAccount)
+		// the relayer work caused by the message is charged before it is dispatched
+		ctx.GasMeter().ConsumeGas(m.GetGasSchedule(ctx).GasCost(contractMsg), "custom message")
+
+		events, data, err := m.dispatchCustomMsg(ctx, contractAddr, contractMsg)
+		if !errors.Is(err, errUnknownCustomMsg) {
+			return events, data, err
 		}
-		if contractMsg.RegisterInterchainQuery != nil {
-			return m.registerInterchainQuery(ctx, contractAddr, contractMsg.RegisterInterchainQuery)
+	}
+
+	return m.Wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// errUnknownCustomMsg is returned by dispatchCustomMsg for a message none of the custom ones,
+// which is passed to the wrapped messenger.
+var errUnknownCustomMsg = errors.New("unknown custom message")
+
+func (m *CustomMessenger) dispatchCustomMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractMsg bindings.NeutronMsg) ([]sdk.Event, [][]byte, error) {
+	if contractMsg.SubmitTx != nil {
+		return m.submitTx(ctx, contractAddr, contractMsg.SubmitTx)
+	}
+	if contractMsg.RegisterInterchainAccount != nil {
+		return m.registerInterchainAccount(ctx, contractAddr, contractMsg.RegisterInterchainAccount)
+	}
+	if contractMsg.RegisterInterchainQuery != nil {
+		return m.registerInterchainQuery(ctx, contractAddr, contractMsg.RegisterInterchainQuery)
+	}
+	if contractMsg.UpdateInterchainQuery != nil {
+		return m.updateInterchainQuery(ctx, contractAddr, contractMsg.UpdateInterchainQuery)
+	}
+	if contractMsg.RemoveInterchainQuery != nil {
+		return m.removeInterchainQuery(ctx, contractAddr, contractMsg.RemoveInterchainQuery)
+	}
+	if contractMsg.IBCTransfer != nil {
+		return m.ibcTransfer(ctx, contractAddr, *contractMsg.IBCTransfer)
+	}
+	if contractMsg.SubmitAdminProposal != nil {
+		return m.submitAdminProposal(ctx, contractAddr, contractMsg.SubmitAdminProposal)
+	}
+	if contractMsg.CreateDenom != nil {
+		return m.createDenom(ctx, contractAddr, contractMsg.CreateDenom)
+	}
+	if contractMsg.Mint != nil {
+		return m.mint(ctx, contractAddr, contractMsg.Mint)
+	}
+	if contractMsg.Burn != nil {
+		return m.burn(ctx, contractAddr, contractMsg.Burn)
+	}
+	if contractMsg.ChangeAdmin != nil {
+		return m.changeAdmin(ctx, contractAddr, contractMsg.ChangeAdmin)
+	}
+	if contractMsg.SetMetadata != nil {
+		return m.setMetadata(ctx, contractAddr, contractMsg.SetMetadata)
+	}
+	if contractMsg.ResubmitFailure != nil {
+		return m.resubmitFailure(ctx, contractAddr, contractMsg.ResubmitFailure)
+	}
+	if contractMsg.Batch != nil {
+		return m.batch(ctx, contractAddr, contractMsg.Batch)
+	}
+
+	return nil, nil, errUnknownCustomMsg
+}
+
+// batch dispatches the messages of the batch in order, committing their changes only if all succeed.
+// The response holds the response of every message, in the same order.
+func (m *CustomMessenger) batch(ctx sdk.Context, contractAddr sdk.AccAddress, batch *bindings.Batch) ([]sdk.Event, [][]byte, error) {
+	if len(batch.Msgs) == 0 {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidBatch, "empty batch")
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	var (
+		events    []sdk.Event
+		responses = make([]json.RawMessage, 0, len(batch.Msgs))
+	)
+	for i, contractMsg := range batch.Msgs {
+		if contractMsg.Batch != nil {
+			return nil, nil, sdkerrors.Wrapf(ErrInvalidBatch, "message %d is a nested batch", i)
 		}
-		if contractMsg.UpdateInterchainQuery != nil {
-			return m.updateInterchainQuery(ctx, contractAddr, contractMsg.UpdateInterchainQuery)
+
+		msgEvents, data, err := m.dispatchCustomMsg(cacheCtx, contractAddr, contractMsg)
+		if errors.Is(err, errUnknownCustomMsg) {
+			return nil, nil, sdkerrors.Wrapf(ErrInvalidBatch, "message %d is an unknown custom message", i)
 		}
-		if contractMsg.RemoveInterchainQuery != nil {
-			return m.removeInterchainQuery(ctx, contractAddr, contractMsg.RemoveInterchainQuery)
+		if err != nil {
+			ctx.Logger().Debug("dispatchCustomMsg: failed to dispatch batch message",
+				"from_address", contractAddr.String(),
+				"index", i,
+				"error", err,
+			)
+			return nil, nil, sdkerrors.Wrapf(err, "failed to dispatch batch message %d", i)
 		}
-		if contractMsg.IBCTransfer != nil {
-			return m.ibcTransfer(ctx, contractAddr, *contractMsg.IBCTransfer)
+
+		events = append(events, msgEvents...)
+		response := json.RawMessage("null")
+		if len(data) > 0 && data[0] != nil {
+			response = data[0]
 		}
+		responses = append(responses, response)
 	}
 
-	return m.Wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	data, err := json.Marshal(bindings.BatchResponse{Responses: responses})
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal batch response to JSON",
+			"from_address", contractAddr.String(),
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "marshal json failed")
+	}
+
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return events, [][]byte{data}, nil
 }
 
-func (m *CustomMessenger) ibcTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, ibcTransferMsg transferwrappertypes.MsgTransfer) ([]sdk.Event, [][]byte, error) {
+// GetGasSchedule returns the gas charged for the custom messages. The schedule parameters
+// not changed by governance keep their default values.
+func (m *CustomMessenger) GetGasSchedule(ctx sdk.Context) GasSchedule {
+	gasSchedule := DefaultGasSchedule()
+	m.paramSpace.GetParamSetIfExists(ctx, &gasSchedule)
+
+	return gasSchedule
+}
+
+func (m *CustomMessenger) submitAdminProposal(ctx sdk.Context, contractAddr sdk.AccAddress, submitAdminProposal *bindings.SubmitAdminProposal) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performSubmitAdminProposal(ctx, contractAddr, submitAdminProposal)
+	if err != nil {
+		ctx.Logger().Debug("performSubmitAdminProposal: failed to submit admin proposal",
+			"from_address", contractAddr.String(),
+			"msg", submitAdminProposal,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to submit admin proposal")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal SubmitAdminProposalResponse response to JSON",
+			"from_address", contractAddr.String(),
+			"msg", submitAdminProposal,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("admin proposal submitted",
+		"from_address", contractAddr.String(),
+		"proposal_id", response.ProposalId,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performSubmitAdminProposal(ctx sdk.Context, contractAddr sdk.AccAddress, submitAdminProposal *bindings.SubmitAdminProposal) (*bindings.SubmitAdminProposalResponse, error) {
+	if !m.isAdminContract(ctx, contractAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an admin contract", contractAddr)
+	}
+
+	content, err := GetAdminProposalContent(submitAdminProposal.AdminProposal)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, submitAdminProposal.InitialDeposit, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to validate incoming SubmitAdminProposal message")
+	}
+
+	response, err := govkeeper.NewMsgServerImpl(*m.govKeeper).SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to submit proposal")
+	}
+
+	return &bindings.SubmitAdminProposalResponse{ProposalId: response.ProposalId}, nil
+}
+
+func (m *CustomMessenger) ibcTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, ibcTransferMsg bindings.IBCTransfer) ([]sdk.Event, [][]byte, error) {
 	ibcTransferMsg.Sender = contractAddr.String()
 
 	if err := ibcTransferMsg.ValidateBasic(); err != nil {
 		return nil, nil, sdkerrors.Wrap(err, "failed to validate ibcTransferMsg")
 	}
+	if len(ibcTransferMsg.CallbackId) > contracttransferstypes.MaxCallbackIDLength {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "callback id longer than %d", contracttransferstypes.MaxCallbackIDLength)
+	}
+	if err := m.msgFilter.CheckMsgTypes(ctx, []sdk.Msg{&ibcTransferMsg.MsgTransfer}); err != nil {
+		return nil, nil, err
+	}
 
-	response, err := m.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &ibcTransferMsg)
+	response, err := m.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &ibcTransferMsg.MsgTransfer)
 	if err != nil {
 		ctx.Logger().Debug("transferServer.Transfer: failed to transfer",
 			"from_address", contractAddr.String(),
@@ -93,9 +311,15 @@ func (m *CustomMessenger) ibcTransfer(ctx sdk.Context, contractAddr sdk.AccAddre
 		return nil, nil, sdkerrors.Wrap(err, "failed to execute IBCTransfer")
 	}
 
-	data, err := json.Marshal(response)
+	m.contractTransfersKeeper.SetTransfer(ctx, contracttransferstypes.NewTransfer(response.Channel, response.SequenceId, ibcTransferMsg.Sender, ibcTransferMsg.CallbackId))
+
+	data, err := json.Marshal(bindings.IBCTransferResponse{
+		SequenceId: response.SequenceId,
+		Channel:    response.Channel,
+		CallbackId: ibcTransferMsg.CallbackId,
+	})
 	if err != nil {
-		ctx.Logger().Error("json.Marshal: failed to marshal MsgTransferResponse response to JSON",
+		ctx.Logger().Error("json.Marshal: failed to marshal IBCTransferResponse response to JSON",
 			"from_address", contractAddr.String(),
 			"msg", response,
 			"error", err,
@@ -150,6 +374,9 @@ func (m *CustomMessenger) performUpdateInterchainQuery(ctx sdk.Context, contract
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to validate incoming UpdateInterchainQuery message")
 	}
+	if err := m.msgFilter.CheckMsgTypes(ctx, []sdk.Msg{&msg}); err != nil {
+		return nil, err
+	}
 
 	response, err := m.Icqmsgserver.UpdateInterchainQuery(sdk.WrapSDKContext(ctx), &msg)
 	if err != nil {
@@ -196,6 +423,9 @@ func (m *CustomMessenger) performRemoveInterchainQuery(ctx sdk.Context, contract
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to validate incoming RemoveInterchainQuery message")
 	}
+	if err := m.msgFilter.CheckMsgTypes(ctx, []sdk.Msg{&msg}); err != nil {
+		return nil, err
+	}
 
 	response, err := m.Icqmsgserver.RemoveInterchainQuery(sdk.WrapSDKContext(ctx), &msg)
 	if err != nil {
@@ -255,6 +485,9 @@ func (m *CustomMessenger) performSubmitTx(ctx sdk.Context, contractAddr sdk.AccA
 	if err := tx.ValidateBasic(); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to validate incoming SubmitTx message")
 	}
+	if err := m.msgFilter.CheckMsgTypes(ctx, []sdk.Msg{&tx}); err != nil {
+		return nil, err
+	}
 
 	response, err := m.Ictxmsgserver.SubmitTx(sdk.WrapSDKContext(ctx), &tx)
 	if err != nil {
@@ -304,13 +537,28 @@ func (m *CustomMessenger) performRegisterInterchainAccount(ctx sdk.Context, cont
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to validate incoming RegisterInterchainAccount message")
 	}
+	if err := m.msgFilter.CheckMsgTypes(ctx, []sdk.Msg{&msg}); err != nil {
+		return nil, err
+	}
+	if reg.Ordering != "" && reg.Ordering != channeltypes.ORDERED.String() {
+		return nil, sdkerrors.Wrapf(ErrUnsupportedChannel, "interchain accounts support only %s channels, got %s", channeltypes.ORDERED, reg.Ordering)
+	}
+	if reg.FeeEnabled {
+		return nil, sdkerrors.Wrap(ErrUnsupportedChannel, "the fee middleware is not enabled")
+	}
 
-	response, err := m.Ictxmsgserver.RegisterInterchainAccount(sdk.WrapSDKContext(ctx), &msg)
+	icaOwner := ictxtypes.NewICAOwnerFromAddress(contractAddr, reg.InterchainAccountId)
+	portID, err := icatypes.NewControllerPortID(icaOwner.String())
 	if err != nil {
+		return nil, err
+	}
+	// the channel is opened by the registration with the next identifier
+	channelID := channeltypes.FormatChannelIdentifier(m.channelKeeper.GetNextChannelSequence(ctx))
+	if err := m.icaControllerKeeper.RegisterInterchainAccount(ctx, reg.ConnectionId, icaOwner.String(), reg.Version); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to register interchain account")
 	}
 
-	return (*bindings.RegisterInterchainAccountResponse)(response), nil
+	return &bindings.RegisterInterchainAccountResponse{PortId: portID, ChannelId: channelID}, nil
 }
 
 func (m *CustomMessenger) registerInterchainQuery(ctx sdk.Context, contractAddr sdk.AccAddress, reg *bindings.RegisterInterchainQuery) ([]sdk.Event, [][]byte, error) {
@@ -365,6 +613,9 @@ func (m *CustomMessenger) performRegisterInterchainQuery(ctx sdk.Context, contra
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to validate incoming RegisterInterchainQuery message")
 	}
+	if err := m.msgFilter.CheckMsgTypes(ctx, []sdk.Msg{&msg}); err != nil {
+		return nil, err
+	}
 
 	response, err := m.Icqmsgserver.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), &msg)
 	if err != nil {
@@ -6,16 +6,29 @@ import (
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	"github.com/Nolus-Protocol/nolus-core/x/mint"
 
 	"github.com/neutron-org/neutron/x/interchainqueries/types"
 	icatypes "github.com/neutron-org/neutron/x/interchaintxs/types"
 )
 
-func (qp *QueryPlugin) GetInterchainQueryResult(ctx sdk.Context, queryID uint64) (*bindings.QueryRegisteredQueryResultResponse, error) {
-	grpcResp, err := qp.icqKeeper.GetQueryResultByID(ctx, queryID)
+func (qp *QueryPlugin) GetInterchainQueryResult(ctx sdk.Context, req *bindings.QueryRegisteredQueryResultRequest) (*bindings.QueryRegisteredQueryResultResponse, error) {
+	grpcResp, err := qp.icqKeeper.GetQueryResultByID(ctx, req.QueryID)
 	if err != nil {
 		return nil, err
 	}
+	registeredQuery, err := qp.icqKeeper.GetQueryByID(ctx, req.QueryID)
+	if err != nil {
+		return nil, err
+	}
+
+	submittedHeight := registeredQuery.GetLastSubmittedResultLocalHeight()
+	if req.MaxAgeBlocks != nil {
+		if age := uint64(ctx.BlockHeight()) - submittedHeight; age > *req.MaxAgeBlocks {
+			return nil, sdkerrors.Wrapf(ErrStaleQueryResult, "submitted %d blocks ago, at height %d, max age is %d blocks", age, submittedHeight, *req.MaxAgeBlocks)
+		}
+	}
+
 	resp := bindings.QueryResult{
 		KvResults: make([]*bindings.StorageValue, 0, len(grpcResp.KvResults)),
 		Height:    grpcResp.GetHeight(),
@@ -30,7 +43,16 @@ func (qp *QueryPlugin) GetInterchainQueryResult(ctx sdk.Context, queryID uint64)
 		resp.KvResults = append(resp.KvResults, &kv)
 	}
 
-	return &bindings.QueryRegisteredQueryResultResponse{Result: &resp}, nil
+	var submittedTime uint64
+	if historicalInfo, found := qp.historicalInfoKeeper.GetHistoricalInfo(ctx, int64(submittedHeight)); found {
+		submittedTime = uint64(historicalInfo.Header.Time.UnixNano())
+	}
+
+	return &bindings.QueryRegisteredQueryResultResponse{
+		Result:                         &resp,
+		LastSubmittedResultLocalHeight: submittedHeight,
+		LastSubmittedResultLocalTime:   submittedTime,
+	}, nil
 }
 
 func (qp *QueryPlugin) GetInterchainAccountAddress(ctx sdk.Context, req *bindings.QueryInterchainAccountAddressRequest) (*bindings.QueryInterchainAccountAddressResponse, error) {
@@ -49,29 +71,123 @@ func (qp *QueryPlugin) GetInterchainAccountAddress(ctx sdk.Context, req *binding
 }
 
 func (qp *QueryPlugin) GetRegisteredInterchainQueries(ctx sdk.Context, query *bindings.QueryRegisteredQueriesRequest) (*bindings.QueryRegisteredQueriesResponse, error) {
-	grpcResp, err := qp.icqKeeper.GetRegisteredQueries(ctx, &types.QueryRegisteredQueriesRequest{
-		Owners:       query.Owners,
-		ConnectionId: query.ConnectionID,
-		Pagination: &sdkquery.PageRequest{
+	pagination := &sdkquery.PageRequest{}
+	if query.Pagination != nil {
+		pagination = &sdkquery.PageRequest{
 			Key:        query.Pagination.Key,
 			Offset:     query.Pagination.Offset,
 			Limit:      query.Pagination.Limit,
 			CountTotal: query.Pagination.CountTotal,
 			Reverse:    query.Pagination.Reverse,
-		},
-	})
+		}
+	}
+
+	var (
+		queries []types.RegisteredQuery
+		pageRes *sdkquery.PageResponse
+		err     error
+	)
+	if query.QueryType == "" && query.Stale == nil {
+		var grpcResp *types.QueryRegisteredQueriesResponse
+		grpcResp, err = qp.icqKeeper.GetRegisteredQueries(ctx, &types.QueryRegisteredQueriesRequest{
+			Owners:       query.Owners,
+			ConnectionId: query.ConnectionID,
+			Pagination:   pagination,
+		})
+		if err == nil {
+			queries, pageRes = grpcResp.GetRegisteredQueries(), grpcResp.GetPagination()
+		}
+	} else {
+		queries, pageRes, err = qp.filterRegisteredQueries(ctx, query, pagination)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	resp := bindings.QueryRegisteredQueriesResponse{RegisteredQueries: make([]bindings.RegisteredQuery, 0, len(grpcResp.GetRegisteredQueries()))}
-	for _, grpcQuery := range grpcResp.GetRegisteredQueries() {
+	resp := bindings.QueryRegisteredQueriesResponse{
+		RegisteredQueries: make([]bindings.RegisteredQuery, 0, len(queries)),
+		Pagination:        pageRes,
+	}
+	for _, grpcQuery := range queries {
 		query := mapGRPCRegisteredQueryToWasmBindings(grpcQuery)
 		resp.RegisteredQueries = append(resp.RegisteredQueries, query)
 	}
 	return &resp, nil
 }
 
+// filterRegisteredQueries lists the queries passing the owners and connection filters of the keeper, and the
+// query type and staleness filters of the request. The pagination follows the one of the keeper, counting only
+// the queries passing all filters, and the next key is the key of the first query left out.
+func (qp *QueryPlugin) filterRegisteredQueries(ctx sdk.Context, query *bindings.QueryRegisteredQueriesRequest, pagination *sdkquery.PageRequest) ([]types.RegisteredQuery, *sdkquery.PageResponse, error) {
+	if query.QueryType != "" && !types.InterchainQueryType(query.QueryType).IsValid() {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidQueryType, "unknown query type %q", query.QueryType)
+	}
+	if pagination.Key != nil && pagination.Offset > 0 {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either offset or key is expected, got both")
+	}
+
+	limit := pagination.Limit
+	if limit == 0 {
+		limit = sdkquery.DefaultLimit
+	}
+	// as in the keeper, the total is counted only when paginating by offset
+	countTotal := pagination.CountTotal && pagination.Key == nil
+
+	var (
+		queries []types.RegisteredQuery
+		matched uint64
+		nextKey []byte
+		page    = &sdkquery.PageRequest{Key: pagination.Key, Limit: limit, Reverse: pagination.Reverse}
+	)
+	for {
+		grpcResp, err := qp.icqKeeper.GetRegisteredQueries(ctx, &types.QueryRegisteredQueriesRequest{
+			Owners:       query.Owners,
+			ConnectionId: query.ConnectionID,
+			Pagination:   page,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, registeredQuery := range grpcResp.GetRegisteredQueries() {
+			if query.QueryType != "" && registeredQuery.GetQueryType() != query.QueryType {
+				continue
+			}
+			if query.Stale != nil && isStaleQuery(ctx, registeredQuery) != *query.Stale {
+				continue
+			}
+
+			matched++
+			switch {
+			case matched <= pagination.Offset:
+			case uint64(len(queries)) < limit:
+				queries = append(queries, registeredQuery)
+			case nextKey == nil:
+				nextKey = sdk.Uint64ToBigEndian(registeredQuery.GetId())
+			}
+			if nextKey != nil && !countTotal {
+				break
+			}
+		}
+
+		if grpcResp.GetPagination().GetNextKey() == nil || (nextKey != nil && !countTotal) {
+			break
+		}
+		page = &sdkquery.PageRequest{Key: grpcResp.GetPagination().GetNextKey(), Limit: limit, Reverse: pagination.Reverse}
+	}
+
+	pageRes := &sdkquery.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = matched
+	}
+	return queries, pageRes, nil
+}
+
+// isStaleQuery tells whether no result of the query has been submitted within its update period.
+func isStaleQuery(ctx sdk.Context, query types.RegisteredQuery) bool {
+	return uint64(ctx.BlockHeight()) > query.GetLastSubmittedResultLocalHeight()+query.GetUpdatePeriod()
+}
+
 func (qp *QueryPlugin) GetRegisteredInterchainQuery(ctx sdk.Context, req *bindings.QueryRegisteredQueryRequest) (*bindings.QueryRegisteredQueryResponse, error) {
 	grpcResp, err := qp.icqKeeper.GetQueryByID(ctx, req.QueryID)
 	if err != nil {
@@ -85,6 +201,69 @@ func (qp *QueryPlugin) GetRegisteredInterchainQuery(ctx sdk.Context, req *bindin
 	return &bindings.QueryRegisteredQueryResponse{RegisteredQuery: &query}, nil
 }
 
+func (qp *QueryPlugin) GetMintState(ctx sdk.Context) *bindings.QueryMintStateResponse {
+	minter := qp.mintKeeper.GetMinter(ctx)
+
+	return &bindings.QueryMintStateResponse{NormTimePassed: minter.NormTimePassed, TotalMinted: minter.TotalMinted}
+}
+
+func (qp *QueryPlugin) GetMintParams(ctx sdk.Context) *bindings.QueryMintParamsResponse {
+	params := qp.mintKeeper.GetParams(ctx)
+
+	return &bindings.QueryMintParamsResponse{MintDenom: params.MintDenom, MaxMintableNanoseconds: params.MaxMintableNanoseconds}
+}
+
+func (qp *QueryPlugin) GetAnnualInflation(ctx sdk.Context) *bindings.QueryAnnualInflationResponse {
+	minter := qp.mintKeeper.GetMinter(ctx)
+
+	return &bindings.QueryAnnualInflationResponse{AnnualInflation: minter.AnnualInflation}
+}
+
+func (qp *QueryPlugin) GetMintProjection(ctx sdk.Context, req *bindings.QueryMintProjectionRequest) *bindings.QueryMintProjectionResponse {
+	minter := qp.mintKeeper.GetMinter(ctx)
+
+	return &bindings.QueryMintProjectionResponse{Months: req.Months, Minted: mint.PredictMinted(minter, req.Months)}
+}
+
+func (qp *QueryPlugin) GetTaxParams(ctx sdk.Context) *bindings.QueryTaxParamsResponse {
+	params := qp.taxKeeper.GetParams(ctx)
+
+	return &bindings.QueryTaxParamsResponse{
+		FeeRate:                    params.FeeRate,
+		ContractAddress:            params.ContractAddress,
+		BaseDenom:                  params.BaseDenom,
+		MinGasPrice:                params.MinGasPrice,
+		FeeDenomMinGasPrices:       params.FeeDenomMinGasPrices,
+		MaxBaseFee:                 params.MaxBaseFee,
+		TargetBlockGas:             params.TargetBlockGas,
+		BaseFeeChangeDenominator:   params.BaseFeeChangeDenominator,
+		UnusedGasRefundRate:        params.UnusedGasRefundRate,
+		DisallowedMsgTypes:         params.DisallowedMsgTypes,
+		BypassMinFeeMsgTypes:       params.BypassMinFeeMsgTypes,
+		MaxBypassMinFeeMsgGasUsage: params.MaxBypassMinFeeMsgGasUsage,
+		PriceFeeders:               params.PriceFeeders,
+		PriceFeedContracts:         params.PriceFeedContracts,
+	}
+}
+
+func (qp *QueryPlugin) GetTaxCollected(ctx sdk.Context) *bindings.QueryTaxCollectedResponse {
+	return &bindings.QueryTaxCollectedResponse{Collected: qp.taxKeeper.GetCollectedTax(ctx)}
+}
+
+func (qp *QueryPlugin) GetIbcTransferStatus(ctx sdk.Context, req *bindings.QueryIbcTransferStatusRequest) (*bindings.QueryIbcTransferStatusResponse, error) {
+	transfer, found := qp.contractTransfersKeeper.GetTransfer(ctx, req.Channel, req.Sequence)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no transfer %d sent by a contract on channel %s, or pruned after its retention window", req.Sequence, req.Channel)
+	}
+
+	return &bindings.QueryIbcTransferStatusResponse{
+		Channel:    transfer.Channel,
+		Sequence:   transfer.Sequence,
+		CallbackId: transfer.CallbackId,
+		Status:     transfer.Status.Name(),
+	}, nil
+}
+
 func mapGRPCRegisteredQueryToWasmBindings(grpcQuery types.RegisteredQuery) bindings.RegisteredQuery {
 	return bindings.RegisteredQuery{
 		ID:                              grpcQuery.GetId(),
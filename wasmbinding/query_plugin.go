@@ -1,16 +1,47 @@
 package wasmbinding
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
 	icqkeeper "github.com/neutron-org/neutron/x/interchainqueries/keeper"
 	icacontrollerkeeper "github.com/neutron-org/neutron/x/interchaintxs/keeper"
+
+	contracttransferskeeper "github.com/Nolus-Protocol/nolus-core/x/contracttransfers/keeper"
+	mintkeeper "github.com/Nolus-Protocol/nolus-core/x/mint/keeper"
+	taxkeeper "github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
 )
 
+// HistoricalInfoKeeper defines the expected keeper of the recent block headers, telling the time
+// the interchain query results were submitted at.
+type HistoricalInfoKeeper interface {
+	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+}
+
 type QueryPlugin struct {
-	icaControllerKeeper *icacontrollerkeeper.Keeper
-	icqKeeper           *icqkeeper.Keeper
+	icaControllerKeeper     *icacontrollerkeeper.Keeper
+	icqKeeper               *icqkeeper.Keeper
+	mintKeeper              *mintkeeper.Keeper
+	taxKeeper               *taxkeeper.Keeper
+	contractTransfersKeeper *contracttransferskeeper.Keeper
+	historicalInfoKeeper    HistoricalInfoKeeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(icaControllerKeeper *icacontrollerkeeper.Keeper, icqKeeper *icqkeeper.Keeper) *QueryPlugin {
-	return &QueryPlugin{icaControllerKeeper: icaControllerKeeper, icqKeeper: icqKeeper}
+func NewQueryPlugin(
+	icaControllerKeeper *icacontrollerkeeper.Keeper,
+	icqKeeper *icqkeeper.Keeper,
+	mintKeeper *mintkeeper.Keeper,
+	taxKeeper *taxkeeper.Keeper,
+	contractTransfersKeeper *contracttransferskeeper.Keeper,
+	historicalInfoKeeper HistoricalInfoKeeper,
+) *QueryPlugin {
+	return &QueryPlugin{
+		icaControllerKeeper:     icaControllerKeeper,
+		icqKeeper:               icqKeeper,
+		mintKeeper:              mintKeeper,
+		taxKeeper:               taxKeeper,
+		contractTransfersKeeper: contractTransfersKeeper,
+		historicalInfoKeeper:    historicalInfoKeeper,
+	}
 }
@@ -0,0 +1,141 @@
+package wasmbinding
+
+import (
+	"fmt"
+	"sort"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+// KeyStargateQueries is the key of the gRPC query paths that contracts are allowed to call through stargate queries.
+var KeyStargateQueries = []byte("StargateQueries")
+
+// stargateResponses maps the gRPC query paths that are deterministic, and so may be allowed by governance,
+// to the constructors of their response types. A new response is decoded for every query, so that
+// the fields unknown to the response type are dropped when it is re-encoded for the contract.
+var stargateResponses = map[string]func() codec.ProtoMarshaler{
+	"/cosmos.auth.v1beta1.Query/Account": func() codec.ProtoMarshaler { return &authtypes.QueryAccountResponse{} },
+	"/cosmos.auth.v1beta1.Query/Params":  func() codec.ProtoMarshaler { return &authtypes.QueryParamsResponse{} },
+
+	"/cosmos.bank.v1beta1.Query/Balance":       func() codec.ProtoMarshaler { return &banktypes.QueryBalanceResponse{} },
+	"/cosmos.bank.v1beta1.Query/DenomMetadata": func() codec.ProtoMarshaler { return &banktypes.QueryDenomMetadataResponse{} },
+	"/cosmos.bank.v1beta1.Query/Params":        func() codec.ProtoMarshaler { return &banktypes.QueryParamsResponse{} },
+	"/cosmos.bank.v1beta1.Query/SupplyOf":      func() codec.ProtoMarshaler { return &banktypes.QuerySupplyOfResponse{} },
+
+	"/cosmos.distribution.v1beta1.Query/Params": func() codec.ProtoMarshaler { return &distrtypes.QueryParamsResponse{} },
+
+	"/cosmos.staking.v1beta1.Query/Delegation": func() codec.ProtoMarshaler { return &stakingtypes.QueryDelegationResponse{} },
+	"/cosmos.staking.v1beta1.Query/Params":     func() codec.ProtoMarshaler { return &stakingtypes.QueryParamsResponse{} },
+	"/cosmos.staking.v1beta1.Query/Pool":       func() codec.ProtoMarshaler { return &stakingtypes.QueryPoolResponse{} },
+	"/cosmos.staking.v1beta1.Query/Validator":  func() codec.ProtoMarshaler { return &stakingtypes.QueryValidatorResponse{} },
+
+	"/ibc.applications.transfer.v1.Query/DenomTrace": func() codec.ProtoMarshaler { return &ibctransfertypes.QueryDenomTraceResponse{} },
+	"/ibc.applications.transfer.v1.Query/Params":     func() codec.ProtoMarshaler { return &ibctransfertypes.QueryParamsResponse{} },
+
+	"/nolus.mint.v1beta1.Query/AnnualInflation": func() codec.ProtoMarshaler { return &minttypes.QueryAnnualInflationResponse{} },
+	"/nolus.mint.v1beta1.Query/MintState":       func() codec.ProtoMarshaler { return &minttypes.QueryMintStateResponse{} },
+	"/nolus.mint.v1beta1.Query/Params":          func() codec.ProtoMarshaler { return &minttypes.QueryParamsResponse{} },
+
+	"/tax.Query/BaseFee":      func() codec.ProtoMarshaler { return &taxtypes.QueryBaseFeeResponse{} },
+	"/tax.Query/MinGasPrices": func() codec.ProtoMarshaler { return &taxtypes.QueryMinGasPricesResponse{} },
+	"/tax.Query/Params":       func() codec.ProtoMarshaler { return &taxtypes.QueryParamsResponse{} },
+}
+
+// DefaultStargateQueries returns the query paths allowed at genesis and by the v0.1.45 upgrade, which are all
+// the supported ones.
+func DefaultStargateQueries() []string {
+	paths := make([]string, 0, len(stargateResponses))
+	for path := range stargateResponses {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// GetStargateQueries returns the query paths that contracts are allowed to call. None is allowed
+// until the paths are set.
+func GetStargateQueries(ctx sdk.Context, paramSpace paramtypes.Subspace) []string {
+	if !paramSpace.Has(ctx, KeyStargateQueries) {
+		return nil
+	}
+
+	var paths []string
+	paramSpace.Get(ctx, KeyStargateQueries, &paths)
+	return paths
+}
+
+// SetStargateQueries sets the query paths that contracts are allowed to call.
+func SetStargateQueries(ctx sdk.Context, paramSpace paramtypes.Subspace, paths []string) {
+	paramSpace.Set(ctx, KeyStargateQueries, paths)
+}
+
+func isStargateQueryAllowed(ctx sdk.Context, paramSpace paramtypes.Subspace, path string) bool {
+	for _, allowed := range GetStargateQueries(ctx, paramSpace) {
+		if allowed == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StargateQuerier serves the gRPC queries allowed by governance. The responses are decoded into their
+// response types and re-encoded as JSON, so that they do not depend on the node answering the query.
+func StargateQuerier(paramSpace paramtypes.Subspace, queryRouter wasmkeeper.GRPCQueryRouter, cdc codec.Codec) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+		newResponse, supported := stargateResponses[request.Path]
+		if !supported || !isStargateQueryAllowed(ctx, paramSpace, request.Path) {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Path)}
+		}
+
+		route := queryRouter.Route(request.Path)
+		if route == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Path)}
+		}
+
+		res, err := route(ctx, abci.RequestQuery{
+			Data: request.Data,
+			Path: request.Path,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return wasmkeeper.ConvertProtoToJSONMarshal(cdc, newResponse(), res.Value)
+	}
+}
+
+func validateStargateQueries(v interface{}) error {
+	paths, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if _, supported := stargateResponses[path]; !supported {
+			return fmt.Errorf("unsupported stargate query: %s", path)
+		}
+
+		if seen[path] {
+			return fmt.Errorf("duplicate stargate query: %s", path)
+		}
+		seen[path] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package wasmbinding_test
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+)
+
+const balancePath = "/cosmos.bank.v1beta1.Query/Balance"
+
+func TestStargateQuerier(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	paramSpace := app.GetSubspace(wasmbinding.ParamsSubspace)
+	querier := wasmbinding.StargateQuerier(paramSpace, app.GRPCQueryRouter(), app.AppCodec())
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("unls", 100))))
+	balanceReq, err := app.AppCodec().Marshal(&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: "unls"})
+	require.NoError(t, err)
+
+	// nothing is allowed until the whitelist is set
+	require.Empty(t, wasmbinding.GetStargateQueries(ctx, paramSpace))
+	_, err = querier(ctx, &wasmvmtypes.StargateQuery{Path: balancePath, Data: balanceReq})
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+
+	wasmbinding.SetStargateQueries(ctx, paramSpace, wasmbinding.DefaultStargateQueries())
+	require.Equal(t, wasmbinding.DefaultStargateQueries(), wasmbinding.GetStargateQueries(ctx, paramSpace))
+	resp, err := querier(ctx, &wasmvmtypes.StargateQuery{Path: balancePath, Data: balanceReq})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"balance":{"denom":"unls","amount":"100"}}`, string(resp))
+
+	// supported by the node but not whitelisted
+	_, err = querier(ctx, &wasmvmtypes.StargateQuery{Path: "/cosmos.bank.v1beta1.Query/AllBalances", Data: balanceReq})
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+
+	// removed from the whitelist by governance
+	paramSpace.Set(ctx, wasmbinding.KeyStargateQueries, []string{"/tax.Query/Params"})
+	_, err = querier(ctx, &wasmvmtypes.StargateQuery{Path: balancePath, Data: balanceReq})
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+
+	resp, err = querier(ctx, &wasmvmtypes.StargateQuery{Path: "/tax.Query/Params"})
+	require.NoError(t, err)
+	require.Contains(t, string(resp), `"fee_rate":40`)
+
+	// only the supported queries may be whitelisted
+	require.Error(t, paramSpace.Update(ctx, wasmbinding.KeyStargateQueries, []byte(`["/cosmos.bank.v1beta1.Query/AllBalances"]`)))
+	require.Error(t, paramSpace.Update(ctx, wasmbinding.KeyStargateQueries, []byte(`["/tax.Query/Params","/tax.Query/Params"]`)))
+	require.NoError(t, paramSpace.Update(ctx, wasmbinding.KeyStargateQueries, []byte(`[]`)))
+	require.Empty(t, wasmbinding.GetStargateQueries(ctx, paramSpace))
+}
+
+// stubQueryRouter answers every query with the same response.
+type stubQueryRouter []byte
+
+func (r stubQueryRouter) Route(string) baseapp.GRPCQueryHandler {
+	return func(sdk.Context, abci.RequestQuery) (abci.ResponseQuery, error) {
+		return abci.ResponseQuery{Value: r}, nil
+	}
+}
+
+func TestStargateQuerierDropsUnknownFields(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+
+	coin := sdk.NewInt64Coin("unls", 100)
+	bz, err := app.AppCodec().Marshal(&banktypes.QueryBalanceResponse{Balance: &coin})
+	require.NoError(t, err)
+	// field 99 with varint value 1, unknown to the response type
+	bz = append(bz, 0x98, 0x06, 0x01)
+
+	paramSpace := app.GetSubspace(wasmbinding.ParamsSubspace)
+	wasmbinding.SetStargateQueries(ctx, paramSpace, []string{balancePath})
+	querier := wasmbinding.StargateQuerier(paramSpace, stubQueryRouter(bz), app.AppCodec())
+	resp, err := querier(ctx, &wasmvmtypes.StargateQuery{Path: balancePath})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"balance":{"denom":"unls","amount":"100"}}`, string(resp))
+}
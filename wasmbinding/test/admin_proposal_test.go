@@ -0,0 +1,109 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+)
+
+func TestAdminProposalJSONRoundTrip(t *testing.T) {
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	testCases := []struct {
+		title      string
+		json       string
+		expContent govtypes.Content
+	}{
+		{
+			title: "param change proposal",
+			json: `{"param_change_proposal":{"title":"title","description":"description",` +
+				`"param_changes":[{"subspace":"tax","key":"FeeRate","value":"30"}]}}`,
+			expContent: paramproposal.NewParameterChangeProposal("title", "description",
+				[]paramproposal.ParamChange{paramproposal.NewParamChange("tax", "FeeRate", `30`)}),
+		},
+		{
+			title: "software upgrade proposal",
+			json: `{"software_upgrade_proposal":{"title":"title","description":"description",` +
+				`"plan":{"name":"v0.2.0","height":100,"info":"info"}}}`,
+			expContent: upgradetypes.NewSoftwareUpgradeProposal("title", "description", upgradetypes.Plan{Name: "v0.2.0", Height: 100, Info: "info"}),
+		},
+		{
+			title:      "cancel software upgrade proposal",
+			json:       `{"cancel_software_upgrade_proposal":{"title":"title","description":"description"}}`,
+			expContent: upgradetypes.NewCancelSoftwareUpgradeProposal("title", "description"),
+		},
+		{
+			title: "client update proposal",
+			json: `{"client_update_proposal":{"title":"title","description":"description",` +
+				`"subject_client_id":"07-tendermint-0","substitute_client_id":"07-tendermint-1"}}`,
+			expContent: ibcclienttypes.NewClientUpdateProposal("title", "description", "07-tendermint-0", "07-tendermint-1"),
+		},
+		{
+			title: "community pool spend proposal",
+			json: `{"community_pool_spend_proposal":{"title":"title","description":"description",` +
+				`"recipient":"` + recipient + `","amount":[{"denom":"unls","amount":"1000"}]}}`,
+			expContent: distrtypes.NewCommunityPoolSpendProposal("title", "description",
+				sdk.MustAccAddressFromBech32(recipient), sdk.NewCoins(sdk.NewInt64Coin("unls", 1000))),
+		},
+		{
+			title:      "pin codes proposal",
+			json:       `{"pin_codes_proposal":{"title":"title","description":"description","code_ids":[1,2]}}`,
+			expContent: &wasmtypes.PinCodesProposal{Title: "title", Description: "description", CodeIDs: []uint64{1, 2}},
+		},
+		{
+			title:      "unpin codes proposal",
+			json:       `{"unpin_codes_proposal":{"title":"title","description":"description","code_ids":[1,2]}}`,
+			expContent: &wasmtypes.UnpinCodesProposal{Title: "title", Description: "description", CodeIDs: []uint64{1, 2}},
+		},
+		{
+			title: "migrate contract proposal",
+			json: `{"migrate_contract_proposal":{"title":"title","description":"description",` +
+				`"contract":"` + recipient + `","code_id":3,"msg":"e30="}}`,
+			expContent: &wasmtypes.MigrateContractProposal{Title: "title", Description: "description",
+				Contract: recipient, CodeID: 3, Msg: []byte("{}")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			msgJSON := `{"submit_admin_proposal":{"admin_proposal":` + tc.json + `,"initial_deposit":[{"denom":"unls","amount":"10"}]}}`
+
+			var msg bindings.NeutronMsg
+			require.NoError(t, json.Unmarshal([]byte(msgJSON), &msg))
+			require.NotNil(t, msg.SubmitAdminProposal)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 10)), msg.SubmitAdminProposal.InitialDeposit)
+
+			bz, err := json.Marshal(msg)
+			require.NoError(t, err)
+			require.JSONEq(t, msgJSON, string(bz))
+
+			content, err := wasmbinding.GetAdminProposalContent(msg.SubmitAdminProposal.AdminProposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.expContent, content)
+		})
+	}
+}
+
+func TestAdminProposalContentKinds(t *testing.T) {
+	_, err := wasmbinding.GetAdminProposalContent(bindings.AdminProposal{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = wasmbinding.GetAdminProposalContent(bindings.AdminProposal{
+		CancelSoftwareUpgradeProposal: &bindings.CancelSoftwareUpgradeProposal{Title: "title", Description: "description"},
+		PinCodesProposal:              &bindings.PinCodesProposal{Title: "title", Description: "description", CodeIDs: []uint64{1}},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
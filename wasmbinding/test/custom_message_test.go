@@ -2,26 +2,43 @@ package test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmvm/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/stretchr/testify/suite"
+	tmdb "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	noluswasmbinding "github.com/Nolus-Protocol/nolus-core/wasmbinding"
 	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers"
+	contracttransferskeeper "github.com/Nolus-Protocol/nolus-core/x/contracttransfers/keeper"
+	contracttransferstypes "github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
 
 	"github.com/neutron-org/neutron/app"
 	"github.com/neutron-org/neutron/app/params"
 	"github.com/neutron-org/neutron/testutil"
 	"github.com/neutron-org/neutron/wasmbinding"
+	contractmanagerkeeper "github.com/neutron-org/neutron/x/contractmanager/keeper"
 	feetypes "github.com/neutron-org/neutron/x/feerefunder/types"
 	icqkeeper "github.com/neutron-org/neutron/x/interchainqueries/keeper"
 	icqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
 	ictxkeeper "github.com/neutron-org/neutron/x/interchaintxs/keeper"
+	"github.com/neutron-org/neutron/x/transfer"
+	transferwrappertypes "github.com/neutron-org/neutron/x/transfer/types"
 )
 
 type CustomMessengerTestSuite struct {
@@ -50,30 +67,120 @@ func (suite *CustomMessengerTestSuite) TestRegisterInterchainAccount() {
 	suite.contractAddress = suite.InstantiateReflectContract(suite.ctx, suite.contractOwner, codeId)
 	suite.Require().NotEmpty(suite.contractAddress)
 
-	// Craft RegisterInterchainAccount message
-	msgStr := []byte(fmt.Sprintf(
-		`
-{
-	"register_interchain_account": {
-		"connection_id": "%s",
-		"interchain_account_id": "%s"
+	paramSpace := suite.neutron.ParamsKeeper.Subspace(noluswasmbinding.ParamsSubspace)
+	messenger := noluswasmbinding.CustomMessageDecorator(&suite.neutron.InterchainTxsKeeper, suite.neutron.ICAControllerKeeper, suite.neutron.IBCKeeper.ChannelKeeper,
+		&suite.neutron.InterchainQueriesKeeper, suite.neutron.TransferKeeper, nil, nil, paramSpace, nil, nil, msgTypeFilter(nil))(nil)
+	register := func(ctx sdk.Context, reg bindings.RegisterInterchainAccount) ([][]byte, error) {
+		msg, err := json.Marshal(bindings.NeutronMsg{RegisterInterchainAccount: &reg})
+		suite.Require().NoError(err)
+
+		// Dispatch RegisterInterchainAccount message
+		events, data, err := messenger.DispatchMsg(ctx, suite.contractAddress, suite.Path.EndpointA.ChannelConfig.PortID, types.CosmosMsg{
+			Custom: msg,
+		})
+		suite.Nil(events)
+		return data, err
 	}
-}
-		`,
-		suite.Path.EndpointA.ConnectionID,
-		testutil.TestInterchainID,
-	))
-	var msg json.RawMessage
-	err := json.Unmarshal(msgStr, &msg)
-	suite.NoError(err)
 
-	// Dispatch RegisterInterchainAccount message
-	events, data, err := suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, suite.Path.EndpointA.ChannelConfig.PortID, types.CosmosMsg{
-		Custom: msg,
-	})
+	reg := bindings.RegisterInterchainAccount{
+		ConnectionId:        suite.Path.EndpointA.ConnectionID,
+		InterchainAccountId: testutil.TestInterchainID,
+	}
+	portID := fmt.Sprintf("icacontroller-%s.%s", suite.contractAddress, testutil.TestInterchainID)
+	channelID := channeltypes.FormatChannelIdentifier(suite.neutron.IBCKeeper.ChannelKeeper.GetNextChannelSequence(suite.ctx))
+	ctx, _ := suite.ctx.CacheContext()
+	data, err := register(ctx, reg)
 	suite.NoError(err)
-	suite.Nil(events)
-	suite.Equal([][]byte{[]byte(`{}`)}, data)
+	suite.Equal([][]byte{[]byte(fmt.Sprintf(`{"port_id":"%s","channel_id":"%s"}`, portID, channelID))}, data)
+	channel, found := suite.neutron.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Equal(channeltypes.ORDERED, channel.Ordering)
+
+	// the requested version is the one of the channel
+	version := icatypes.NewDefaultMetadataString(suite.Path.EndpointA.ConnectionID, suite.Path.EndpointB.ConnectionID)
+	ctx, _ = suite.ctx.CacheContext()
+	reg.Ordering, reg.Version = channeltypes.ORDERED.String(), version
+	_, err = register(ctx, reg)
+	suite.NoError(err)
+	channel, found = suite.neutron.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Equal(version, channel.Version)
+
+	ctx, _ = suite.ctx.CacheContext()
+	reg.Version = "not a version"
+	_, err = register(ctx, reg)
+	suite.ErrorIs(err, icatypes.ErrUnknownDataType)
+
+	reg.Version, reg.Ordering = "", channeltypes.UNORDERED.String()
+	_, err = register(ctx, reg)
+	suite.ErrorIs(err, noluswasmbinding.ErrUnsupportedChannel)
+
+	reg.Ordering, reg.FeeEnabled = "", true
+	_, err = register(ctx, reg)
+	suite.ErrorIs(err, noluswasmbinding.ErrUnsupportedChannel)
+}
+
+func (suite *CustomMessengerTestSuite) TestBatch() {
+	// Store code and instantiate reflect contract
+	codeId := suite.StoreReflectCode(suite.ctx, suite.contractOwner, "../testdata/reflect.wasm")
+	suite.contractAddress = suite.InstantiateReflectContract(suite.ctx, suite.contractOwner, codeId)
+	suite.Require().NotEmpty(suite.contractAddress)
+
+	paramSpace := suite.neutron.ParamsKeeper.Subspace(noluswasmbinding.ParamsSubspace)
+	messenger := noluswasmbinding.CustomMessageDecorator(&suite.neutron.InterchainTxsKeeper, suite.neutron.ICAControllerKeeper, suite.neutron.IBCKeeper.ChannelKeeper,
+		&suite.neutron.InterchainQueriesKeeper, suite.neutron.TransferKeeper, nil, nil, paramSpace, nil, nil, msgTypeFilter(nil))(nil)
+	dispatch := func(ctx sdk.Context, msgs ...bindings.NeutronMsg) ([][]byte, error) {
+		msg, err := json.Marshal(bindings.NeutronMsg{Batch: &bindings.Batch{Msgs: msgs}})
+		suite.Require().NoError(err)
+
+		_, data, err := messenger.DispatchMsg(ctx, suite.contractAddress, suite.Path.EndpointA.ChannelConfig.PortID, types.CosmosMsg{
+			Custom: msg,
+		})
+		return data, err
+	}
+	registerAccount := func(interchainAccountID string, ordering string) bindings.NeutronMsg {
+		return bindings.NeutronMsg{RegisterInterchainAccount: &bindings.RegisterInterchainAccount{
+			ConnectionId:        suite.Path.EndpointA.ConnectionID,
+			InterchainAccountId: interchainAccountID,
+			Ordering:            ordering,
+		}}
+	}
+	portID := func(interchainAccountID string) string {
+		return fmt.Sprintf("icacontroller-%s.%s", suite.contractAddress, interchainAccountID)
+	}
+
+	// all the messages are dispatched, and their responses returned in order
+	ctx, _ := suite.ctx.CacheContext()
+	sequence := suite.neutron.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx)
+	data, err := dispatch(ctx, registerAccount("first", ""), registerAccount("second", ""))
+	suite.Require().NoError(err)
+	suite.Require().Len(data, 1)
+	var resp bindings.BatchResponse
+	suite.Require().NoError(json.Unmarshal(data[0], &resp))
+	suite.Require().Len(resp.Responses, 2)
+	for i, id := range []string{"first", "second"} {
+		var registered bindings.RegisterInterchainAccountResponse
+		suite.Require().NoError(json.Unmarshal(resp.Responses[i], &registered))
+		suite.Equal(portID(id), registered.PortId)
+		suite.Equal(channeltypes.FormatChannelIdentifier(sequence+uint64(i)), registered.ChannelId)
+		_, found := suite.neutron.IBCKeeper.ChannelKeeper.GetChannel(ctx, registered.PortId, registered.ChannelId)
+		suite.True(found)
+	}
+
+	// a failing message reverts the ones before it
+	ctx, _ = suite.ctx.CacheContext()
+	_, err = dispatch(ctx, registerAccount("first", ""), registerAccount("second", channeltypes.UNORDERED.String()))
+	suite.ErrorIs(err, noluswasmbinding.ErrUnsupportedChannel)
+	suite.Equal(sequence, suite.neutron.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx))
+	suite.False(suite.neutron.IBCKeeper.PortKeeper.IsBound(ctx, portID("first")))
+
+	_, err = dispatch(ctx)
+	suite.ErrorIs(err, noluswasmbinding.ErrInvalidBatch)
+	_, err = dispatch(ctx, registerAccount("first", ""), bindings.NeutronMsg{Batch: &bindings.Batch{Msgs: []bindings.NeutronMsg{registerAccount("second", "")}}})
+	suite.ErrorIs(err, noluswasmbinding.ErrInvalidBatch)
+	_, err = dispatch(ctx, registerAccount("first", ""), bindings.NeutronMsg{})
+	suite.ErrorIs(err, noluswasmbinding.ErrInvalidBatch)
+	suite.Equal(sequence, suite.neutron.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx))
 }
 
 func (suite *CustomMessengerTestSuite) TestRegisterInterchainQuery() {
@@ -291,6 +398,183 @@ func (suite *CustomMessengerTestSuite) TestSubmitTxTooMuchTxs() {
 	suite.ErrorContains(err, "MsgSubmitTx contains more messages than allowed")
 }
 
+func (suite *CustomMessengerTestSuite) TestIBCTransferCallback() {
+	suite.ConfigureTransferChannel()
+	ctx, contractTransfersKeeper, contractManager := suite.withContractTransfers(suite.ctx)
+
+	// Store code and instantiate reflect contract
+	codeId := suite.StoreReflectCode(ctx, suite.contractOwner, "../testdata/reflect.wasm")
+	suite.contractAddress = suite.InstantiateReflectContract(ctx, suite.contractOwner, codeId)
+	suite.Require().NotEmpty(suite.contractAddress)
+
+	// Top up contract balance for the transfers and their fees
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	coinsAmnt := sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, sdk.NewInt(int64(10_000_000))))
+	suite.Require().NoError(suite.neutron.BankKeeper.SendCoins(ctx, senderAddress, suite.contractAddress, coinsAmnt))
+
+	paramSpace := suite.neutron.ParamsKeeper.Subspace(noluswasmbinding.ParamsSubspace)
+	messenger := noluswasmbinding.CustomMessageDecorator(&suite.neutron.InterchainTxsKeeper, suite.neutron.ICAControllerKeeper, suite.neutron.IBCKeeper.ChannelKeeper, &suite.neutron.InterchainQueriesKeeper, suite.neutron.TransferKeeper,
+		contractTransfersKeeper, nil, paramSpace, nil, nil, msgTypeFilter(nil))(nil)
+	querier := noluswasmbinding.CustomQuerier(noluswasmbinding.NewQueryPlugin(nil, nil, nil, nil, contractTransfersKeeper, nil))
+	// the transfer module calls back the contracts through the contract transfers keeper
+	transferKeeper := suite.neutron.TransferKeeper
+	transferKeeper.ContractManagerKeeper = contractTransfersKeeper
+	middleware := contracttransfers.NewIBCMiddleware(transfer.NewIBCModule(transferKeeper), *contractTransfersKeeper)
+
+	channel := suite.TransferPath.EndpointA.ChannelID
+	receiver := suite.ChainB.SenderAccounts[0].SenderAccount.GetAddress().String()
+	timeoutHeight := clienttypes.NewHeight(10, 10000)
+	sendTransfer := func(callbackID string) []byte {
+		msg, err := json.Marshal(bindings.NeutronMsg{
+			IBCTransfer: &bindings.IBCTransfer{
+				MsgTransfer: transferwrappertypes.MsgTransfer{
+					SourcePort:    suite.TransferPath.EndpointA.ChannelConfig.PortID,
+					SourceChannel: channel,
+					Token:         sdk.NewCoin(params.DefaultDenom, sdk.NewInt(1000)),
+					Receiver:      receiver,
+					TimeoutHeight: timeoutHeight,
+					Fee: feetypes.Fee{
+						RecvFee:    sdk.NewCoins(),
+						AckFee:     sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, sdk.NewInt(1000))),
+						TimeoutFee: sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, sdk.NewInt(1000))),
+					},
+				},
+				CallbackId: callbackID,
+			},
+		})
+		suite.Require().NoError(err)
+
+		events, data, err := messenger.DispatchMsg(ctx, suite.contractAddress, suite.TransferPath.EndpointA.ChannelConfig.PortID, types.CosmosMsg{
+			Custom: msg,
+		})
+		suite.Require().NoError(err)
+		suite.Nil(events)
+		suite.Require().Len(data, 1)
+		return data[0]
+	}
+	transferStatus := func(sequence uint64) string {
+		bz, err := querier(ctx, []byte(fmt.Sprintf(`{"ibc_transfer_status": {"channel": "%s", "sequence": %d}}`, channel, sequence)))
+		suite.Require().NoError(err)
+		return string(bz)
+	}
+	packet := func(sequence uint64) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(params.DefaultDenom, "1000", suite.contractAddress.String(), receiver)
+		return channeltypes.NewPacket(data.GetBytes(), sequence,
+			suite.TransferPath.EndpointA.ChannelConfig.PortID, channel,
+			suite.TransferPath.EndpointB.ChannelConfig.PortID, suite.TransferPath.EndpointB.ChannelID,
+			timeoutHeight, 0,
+		)
+	}
+
+	// The response correlates the transfer with the callback id
+	suite.JSONEq(fmt.Sprintf(`{"sequence_id": 1, "channel": "%s", "callback_id": "refund-1"}`, channel), string(sendTransfer("refund-1")))
+	suite.JSONEq(fmt.Sprintf(`{"sequence_id": 2, "channel": "%s"}`, channel), string(sendTransfer("")))
+	suite.JSONEq(fmt.Sprintf(`{"sequence_id": 3, "channel": "%s", "callback_id": "refund-3"}`, channel), string(sendTransfer("refund-3")))
+	suite.JSONEq(fmt.Sprintf(`{"channel": "%s", "sequence": 1, "callback_id": "refund-1", "status": "pending"}`, channel), transferStatus(1))
+
+	// The callback id is added to the callbacks of the transfers, which are kept with their outcome once settled
+	errAck := channeltypes.NewErrorAcknowledgement(errors.New("rejected"))
+	suite.Require().NoError(middleware.OnAcknowledgementPacket(ctx, packet(1), errAck.Acknowledgement(), senderAddress))
+	suite.Require().NoError(middleware.OnTimeoutPacket(ctx, packet(2), senderAddress))
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().NoError(middleware.OnAcknowledgementPacket(ctx, packet(3), ack.Acknowledgement(), senderAddress))
+
+	suite.Require().Len(contractManager.msgs, 2)
+	var sudo contracttransferstypes.MessageError
+	suite.Require().NoError(json.Unmarshal(contractManager.msgs[0], &sudo))
+	suite.Equal("refund-1", sudo.Error.CallbackID)
+	suite.Equal(errAck.GetError(), sudo.Error.Details)
+	suite.Equal(uint64(1), sudo.Error.Request.Sequence)
+	var response contracttransferstypes.MessageResponse
+	suite.Require().NoError(json.Unmarshal(contractManager.msgs[1], &response))
+	suite.Equal("refund-3", response.Response.CallbackID)
+
+	suite.JSONEq(fmt.Sprintf(`{"channel": "%s", "sequence": 1, "callback_id": "refund-1", "status": "error"}`, channel), transferStatus(1))
+	suite.JSONEq(fmt.Sprintf(`{"channel": "%s", "sequence": 2, "callback_id": "", "status": "timeout"}`, channel), transferStatus(2))
+	suite.JSONEq(fmt.Sprintf(`{"channel": "%s", "sequence": 3, "callback_id": "refund-3", "status": "acknowledged"}`, channel), transferStatus(3))
+
+	// The settled transfers are pruned after the retention window
+	contractTransfersKeeper.PruneSettledTransfers(ctx.WithBlockHeight(ctx.BlockHeight() + contracttransferstypes.SettledTransferRetention - 1))
+	suite.Len(contractTransfersKeeper.GetAllTransfers(ctx), 3)
+	contractTransfersKeeper.PruneSettledTransfers(ctx.WithBlockHeight(ctx.BlockHeight() + contracttransferstypes.SettledTransferRetention))
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		_, err := querier(ctx, []byte(fmt.Sprintf(`{"ibc_transfer_status": {"channel": "%s", "sequence": %d}}`, channel, sequence)))
+		suite.ErrorContains(err, fmt.Sprintf("no transfer %d sent by a contract", sequence))
+	}
+	suite.Empty(contractTransfersKeeper.GetAllTransfers(ctx))
+}
+
+// multiStoreWithContractTransfers adds the store of the contracttransfers module,
+// missing from the neutron app, to the stores of the app.
+type multiStoreWithContractTransfers struct {
+	sdk.MultiStore
+
+	key   sdk.StoreKey
+	store sdk.KVStore
+}
+
+func (ms multiStoreWithContractTransfers) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	if key == ms.key {
+		return ms.store
+	}
+
+	return ms.MultiStore.GetKVStore(key)
+}
+
+// CacheMultiStore caches the stores of the app, the contracttransfers store is written through.
+func (ms multiStoreWithContractTransfers) CacheMultiStore() sdk.CacheMultiStore {
+	return cacheMultiStoreWithContractTransfers{
+		cacheMultiStore: ms.MultiStore.CacheMultiStore(),
+		key:             ms.key,
+		store:           ms.store,
+	}
+}
+
+// cacheMultiStore names the embedded store apart from its CacheMultiStore method.
+type cacheMultiStore = sdk.CacheMultiStore
+
+type cacheMultiStoreWithContractTransfers struct {
+	cacheMultiStore
+
+	key   sdk.StoreKey
+	store sdk.KVStore
+}
+
+func (ms cacheMultiStoreWithContractTransfers) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	if key == ms.key {
+		return ms.store
+	}
+
+	return ms.cacheMultiStore.GetKVStore(key)
+}
+
+// sudoRecorder wraps the contract manager of the neutron app, recording the sudo messages built by the
+// contract transfers keeper.
+type sudoRecorder struct {
+	contractmanagerkeeper.Keeper
+
+	wasmKeeper *wasm.Keeper
+	msgs       [][]byte
+}
+
+func (r *sudoRecorder) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, _ channeltypes.Packet, msg []byte) ([]byte, error) {
+	r.msgs = append(r.msgs, msg)
+	return r.wasmKeeper.Sudo(ctx, contractAddress, msg)
+}
+
+func (suite *CustomMessengerTestSuite) withContractTransfers(ctx sdk.Context) (sdk.Context, *contracttransferskeeper.Keeper, *sudoRecorder) {
+	key := sdk.NewKVStoreKey(contracttransferstypes.StoreKey)
+	multiStore := multiStoreWithContractTransfers{
+		MultiStore: ctx.MultiStore(),
+		key:        key,
+		store:      dbadapter.Store{DB: tmdb.NewMemDB()},
+	}
+
+	contractManager := &sudoRecorder{Keeper: suite.neutron.ContractManagerKeeper, wasmKeeper: &suite.neutron.WasmKeeper}
+
+	return ctx.WithMultiStore(multiStore), contracttransferskeeper.NewKeeper(suite.neutron.AppCodec(), key, contractManager), contractManager
+}
+
 func (suite *CustomMessengerTestSuite) craftMarshaledMsgSubmitTxWithNumMsgs(numMsgs int) (result []byte) {
 	msg := bindings.ProtobufAny{
 		TypeURL: "/cosmos.staking.v1beta1.MsgDelegate",
@@ -320,6 +604,48 @@ func (suite *CustomMessengerTestSuite) craftMarshaledMsgSubmitTxWithNumMsgs(numM
 	return
 }
 
+func (suite *CustomMessengerTestSuite) TestDisallowedMsgType() {
+	transferMsgType := sdk.MsgTypeURL(&transferwrappertypes.MsgTransfer{})
+	paramSpace := suite.neutron.ParamsKeeper.Subspace(noluswasmbinding.ParamsSubspace)
+	messenger := noluswasmbinding.CustomMessageDecorator(&suite.neutron.InterchainTxsKeeper, suite.neutron.ICAControllerKeeper, suite.neutron.IBCKeeper.ChannelKeeper,
+		&suite.neutron.InterchainQueriesKeeper, suite.neutron.TransferKeeper, nil, nil, paramSpace, nil, nil, msgTypeFilter{transferMsgType})(nil)
+
+	msg, err := json.Marshal(bindings.NeutronMsg{
+		IBCTransfer: &bindings.IBCTransfer{
+			MsgTransfer: transferwrappertypes.MsgTransfer{
+				SourcePort:    "transfer",
+				SourceChannel: "channel-0",
+				Token:         sdk.NewCoin(params.DefaultDenom, sdk.NewInt(1000)),
+				Receiver:      keeper.RandomBech32AccountAddress(suite.T()),
+				TimeoutHeight: clienttypes.NewHeight(10, 10000),
+				Fee: feetypes.Fee{
+					RecvFee:    sdk.NewCoins(),
+					AckFee:     sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, sdk.NewInt(1000))),
+					TimeoutFee: sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, sdk.NewInt(1000))),
+				},
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	// the custom messages are subject to the disallowed message types the same as the ones routed by wasm
+	_, _, err = messenger.DispatchMsg(suite.ctx, suite.contractOwner, "", types.CosmosMsg{Custom: msg})
+	suite.Require().ErrorIs(err, taxtypes.ErrDisallowedMsgType)
+}
+
+// msgTypeFilter rejects the messages of the given type URLs, standing in for the tax keeper of Nolus.
+type msgTypeFilter []string
+
+func (f msgTypeFilter) CheckMsgTypes(_ sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if msgTypeURL := sdk.MsgTypeURL(msg); taxtypes.ContainsMsgType(f, msgTypeURL) {
+			return sdkerrors.Wrap(taxtypes.ErrDisallowedMsgType, msgTypeURL)
+		}
+	}
+
+	return nil
+}
+
 func TestMessengerTestSuite(t *testing.T) {
 	suite.Run(t, new(CustomMessengerTestSuite))
 }
@@ -8,11 +8,19 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	nolusparams "github.com/Nolus-Protocol/nolus-core/app/params"
+	testkeeper "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
 	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	"github.com/Nolus-Protocol/nolus-core/x/mint"
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
 
 	"github.com/neutron-org/neutron/app"
 	"github.com/neutron-org/neutron/testutil"
@@ -120,6 +128,177 @@ func (suite *CustomQuerierTestSuite) TestInterchainQueryResultNotFound() {
 	suite.Require().ErrorContains(err, expectedErrMsg)
 }
 
+func (suite *CustomQuerierTestSuite) TestInterchainQueryResultFreshness() {
+	var (
+		neutron = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx     = suite.ChainA.GetContext()
+		querier = wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(nil, &neutron.InterchainQueriesKeeper, nil, nil, nil, neutron.GetConsumerKeeper()))
+	)
+
+	// Register and submit query result
+	queryID := neutron.InterchainQueriesKeeper.GetLastRegisteredQueryKey(ctx) + 1
+	neutron.InterchainQueriesKeeper.SetLastRegisteredQueryKey(ctx, queryID)
+	suite.Require().NoError(neutron.InterchainQueriesKeeper.SaveQuery(ctx, icqtypes.RegisteredQuery{
+		Id:           queryID,
+		Keys:         []*icqtypes.KVKey{{Path: host.StoreKey, Key: host.FullClientStateKey(suite.Path.EndpointB.ClientID)}},
+		QueryType:    string(icqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+	}))
+	suite.Require().NoError(neutron.InterchainQueriesKeeper.SaveKVQueryResult(ctx, queryID, &icqtypes.QueryResult{
+		KvResults: []*icqtypes.StorageValue{{Key: []byte("key"), Value: []byte("value"), StoragePrefix: host.StoreKey}},
+		Height:    10,
+		Revision:  1,
+	}))
+	submittedHeight, submittedTime := uint64(ctx.BlockHeight()), ctx.BlockTime()
+	suite.Require().NoError(neutron.InterchainQueriesKeeper.UpdateLastLocalHeight(ctx, queryID, submittedHeight))
+	suite.Coordinator.CommitNBlocks(suite.ChainA, 3)
+	ctx = suite.ChainA.GetContext()
+	age := uint64(ctx.BlockHeight()) - submittedHeight
+
+	query := func(maxAgeBlocks *uint64) (bindings.QueryRegisteredQueryResultResponse, error) {
+		request, err := json.Marshal(bindings.NeutronQuery{
+			InterchainQueryResult: &bindings.QueryRegisteredQueryResultRequest{QueryID: queryID, MaxAgeBlocks: maxAgeBlocks},
+		})
+		suite.Require().NoError(err)
+
+		var resp bindings.QueryRegisteredQueryResultResponse
+		bz, err := querier(ctx, request)
+		if err != nil {
+			return resp, err
+		}
+		suite.Require().NoError(json.Unmarshal(bz, &resp))
+		return resp, nil
+	}
+
+	resp, err := query(nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(10), resp.Result.Height)
+	suite.Require().Equal(submittedHeight, resp.LastSubmittedResultLocalHeight)
+	suite.Require().Equal(uint64(submittedTime.UnixNano()), resp.LastSubmittedResultLocalTime)
+
+	resp, err = query(&age)
+	suite.Require().NoError(err)
+	suite.Require().Equal(submittedHeight, resp.LastSubmittedResultLocalHeight)
+
+	tooOld := age - 1
+	_, err = query(&tooOld)
+	suite.Require().ErrorIs(err, wasmbinding.ErrStaleQueryResult)
+
+	// the time is omitted once the header of the submission block is no longer kept
+	neutron.GetConsumerKeeper().DeleteHistoricalInfo(ctx, int64(submittedHeight))
+	bz, err := querier(ctx, []byte(fmt.Sprintf(`{"interchain_query_result":{"query_id":%d}}`, queryID)))
+	suite.Require().NoError(err)
+	suite.Require().NotContains(string(bz), "last_submitted_result_local_time")
+	suite.Require().Contains(string(bz), fmt.Sprintf(`"last_submitted_result_local_height":%d`, submittedHeight))
+}
+
+func (suite *CustomQuerierTestSuite) TestRegisteredInterchainQueriesFilters() {
+	var (
+		neutron = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx     = suite.ChainA.GetContext()
+		owner   = keeper.RandomAccountAddress(suite.T())
+		querier = wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(nil, &neutron.InterchainQueriesKeeper, nil, nil, nil, neutron.GetConsumerKeeper()))
+	)
+
+	// kv fresh, tx stale, kv stale, kv fresh, tx fresh
+	registered := []struct {
+		queryType icqtypes.InterchainQueryType
+		stale     bool
+	}{
+		{icqtypes.InterchainQueryTypeKV, false},
+		{icqtypes.InterchainQueryTypeTX, true},
+		{icqtypes.InterchainQueryTypeKV, true},
+		{icqtypes.InterchainQueryTypeKV, false},
+		{icqtypes.InterchainQueryTypeTX, false},
+	}
+	ids := make([]uint64, 0, len(registered))
+	for _, r := range registered {
+		id := neutron.InterchainQueriesKeeper.GetLastRegisteredQueryKey(ctx) + 1
+		neutron.InterchainQueriesKeeper.SetLastRegisteredQueryKey(ctx, id)
+		updatePeriod := uint64(ctx.BlockHeight()) + 100
+		if r.stale {
+			updatePeriod = 1
+		}
+		suite.Require().NoError(neutron.InterchainQueriesKeeper.SaveQuery(ctx, icqtypes.RegisteredQuery{
+			Id:           id,
+			Owner:        owner.String(),
+			QueryType:    string(r.queryType),
+			UpdatePeriod: updatePeriod,
+			ConnectionId: suite.Path.EndpointA.ConnectionID,
+		}))
+		ids = append(ids, id)
+	}
+	suite.Require().Greater(ctx.BlockHeight(), int64(1))
+
+	query := func(request bindings.QueryRegisteredQueriesRequest) ([]uint64, *sdkquery.PageResponse, error) {
+		request.Owners = []string{owner.String()}
+		bz, err := json.Marshal(bindings.NeutronQuery{RegisteredInterchainQueries: &request})
+		suite.Require().NoError(err)
+		bz, err = querier(ctx, bz)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var resp bindings.QueryRegisteredQueriesResponse
+		suite.Require().NoError(json.Unmarshal(bz, &resp))
+		listed := make([]uint64, 0, len(resp.RegisteredQueries))
+		for _, q := range resp.RegisteredQueries {
+			listed = append(listed, q.ID)
+		}
+		return listed, resp.Pagination, nil
+	}
+
+	// without the new filters the pagination of the keeper is returned
+	listed, page, err := query(bindings.QueryRegisteredQueriesRequest{Pagination: &sdkquery.PageRequest{Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ids[:2], listed)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(ids[2]), page.NextKey)
+
+	// the kv queries, a page at a time
+	stale, fresh := true, false
+	var kv []uint64
+	for key := []byte(nil); ; key = page.NextKey {
+		listed, page, err = query(bindings.QueryRegisteredQueriesRequest{
+			QueryType:  string(icqtypes.InterchainQueryTypeKV),
+			Pagination: &sdkquery.PageRequest{Key: key, Limit: 1},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(listed, 1)
+		kv = append(kv, listed...)
+		if page.NextKey == nil {
+			break
+		}
+	}
+	suite.Require().Equal([]uint64{ids[0], ids[2], ids[3]}, kv)
+
+	listed, page, err = query(bindings.QueryRegisteredQueriesRequest{Stale: &stale})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{ids[1], ids[2]}, listed)
+	suite.Require().Nil(page.NextKey)
+
+	listed, page, err = query(bindings.QueryRegisteredQueriesRequest{
+		QueryType:  string(icqtypes.InterchainQueryTypeTX),
+		Stale:      &fresh,
+		Pagination: &sdkquery.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{ids[4]}, listed)
+	suite.Require().Equal(uint64(1), page.Total)
+
+	listed, page, err = query(bindings.QueryRegisteredQueriesRequest{
+		Stale:      &fresh,
+		Pagination: &sdkquery.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{ids[3]}, listed)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(ids[4]), page.NextKey)
+	suite.Require().Equal(uint64(3), page.Total)
+
+	_, _, err = query(bindings.QueryRegisteredQueriesRequest{QueryType: "unknown"})
+	suite.Require().ErrorIs(err, icqtypes.ErrInvalidQueryType)
+}
+
 func (suite *CustomQuerierTestSuite) TestInterchainAccountAddress() {
 	var (
 		ctx   = suite.ChainA.GetContext()
@@ -215,6 +394,103 @@ func (suite *CustomQuerierTestSuite) queryCustom(ctx sdk.Context, contract sdk.A
 	return json.Unmarshal(resp.Data, response)
 }
 
+func TestMintQueries(t *testing.T) {
+	mintKeeper, ctx := testkeeper.MintKeeper(t)
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(nil, nil, mintKeeper, nil, nil, nil))
+
+	minter := minttypes.NewMinter(sdk.MustNewDecFromStr("12.5"), sdk.NewUint(1_000_000), sdk.NewUint(1), sdk.NewUint(500_000))
+	mintKeeper.SetMinter(ctx, minter)
+	projected := mint.PredictMinted(minter, 6)
+	require.True(t, projected.GT(sdk.ZeroUint()))
+	require.True(t, projected.LT(mint.PredictMinted(minter, 12)))
+
+	testCases := []struct {
+		name    string
+		query   bindings.NeutronQuery
+		expJSON string
+	}{
+		{
+			name:    "mint state",
+			query:   bindings.NeutronQuery{MintState: &bindings.QueryMintStateRequest{}},
+			expJSON: `{"norm_time_passed":"12.500000000000000000","total_minted":"1000000"}`,
+		},
+		{
+			name:    "mint params",
+			query:   bindings.NeutronQuery{MintParams: &bindings.QueryMintParamsRequest{}},
+			expJSON: `{"mint_denom":"stake","max_mintable_nanoseconds":"60000000000"}`,
+		},
+		{
+			name:    "annual inflation",
+			query:   bindings.NeutronQuery{AnnualInflation: &bindings.QueryAnnualInflationRequest{}},
+			expJSON: `{"annual_inflation":"500000"}`,
+		},
+		{
+			name:    "mint projection",
+			query:   bindings.NeutronQuery{MintProjection: &bindings.QueryMintProjectionRequest{Months: 6}},
+			expJSON: fmt.Sprintf(`{"months":6,"minted":"%s"}`, projected),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request, err := json.Marshal(tc.query)
+			require.NoError(t, err)
+
+			resp, err := querier(ctx, request)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expJSON, string(resp))
+		})
+	}
+}
+
+func TestTaxQueries(t *testing.T) {
+	// the tax params hold nolus addresses which are only parsed, never cached, so the neutron prefixes are safe to restore
+	nolusparams.SetAddressPrefixes()
+	defer app.GetDefaultConfig()
+
+	taxKeeper, ctx := testkeeper.TaxKeeper(t)
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(nil, nil, nil, taxKeeper, nil, nil))
+
+	query := func(query bindings.NeutronQuery) []byte {
+		request, err := json.Marshal(query)
+		require.NoError(t, err)
+
+		resp, err := querier(ctx, request)
+		require.NoError(t, err)
+		return resp
+	}
+
+	params := taxtypes.DefaultParams()
+	params.FeeDenomMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uosmo", sdk.MustNewDecFromStr("0.01")))
+	params.DisallowedMsgTypes = nil
+	taxKeeper.SetParams(ctx, params)
+
+	resp := query(bindings.NeutronQuery{TaxParams: &bindings.QueryTaxParamsRequest{}})
+	var taxParams bindings.QueryTaxParamsResponse
+	require.NoError(t, json.Unmarshal(resp, &taxParams))
+	require.Equal(t, params.FeeRate, taxParams.FeeRate)
+	require.Equal(t, params.ContractAddress, taxParams.ContractAddress)
+	require.Equal(t, params.BaseDenom, taxParams.BaseDenom)
+	require.Equal(t, params.MinGasPrice, taxParams.MinGasPrice)
+	require.Equal(t, params.FeeDenomMinGasPrices, taxParams.FeeDenomMinGasPrices)
+	require.Equal(t, params.MaxBaseFee, taxParams.MaxBaseFee)
+	require.Equal(t, params.UnusedGasRefundRate, taxParams.UnusedGasRefundRate)
+	require.Equal(t, params.BypassMinFeeMsgTypes, taxParams.BypassMinFeeMsgTypes)
+	require.Equal(t, params.MaxBypassMinFeeMsgGasUsage, taxParams.MaxBypassMinFeeMsgGasUsage)
+	// empty lists are rendered as arrays, not null
+	require.Contains(t, string(resp), `"disallowed_msg_types":[]`)
+	require.Contains(t, string(resp), `"price_feeders":[]`)
+	require.Contains(t, string(resp), `"min_gas_price":"0.002500000000000000"`)
+
+	resp = query(bindings.NeutronQuery{TaxCollected: &bindings.QueryTaxCollectedRequest{}})
+	require.JSONEq(t, `{"collected":[]}`, string(resp))
+
+	taxKeeper.AppendTxFee(ctx, taxtypes.TxFee{Fee: sdk.NewInt64Coin("unls", 25)})
+	taxKeeper.AppendTxFee(ctx, taxtypes.TxFee{Fee: sdk.NewInt64Coin("unls", 12)})
+	resp = query(bindings.NeutronQuery{TaxCollected: &bindings.QueryTaxCollectedRequest{}})
+	require.JSONEq(t, `{"collected":[{"denom":"unls","amount":"14"}]}`, string(resp))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(CustomQuerierTestSuite))
 }
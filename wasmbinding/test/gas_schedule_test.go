@@ -0,0 +1,171 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmvm/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+
+	"github.com/neutron-org/neutron/app"
+	"github.com/neutron-org/neutron/app/params"
+	"github.com/neutron-org/neutron/testutil"
+	icqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+func TestGasCost(t *testing.T) {
+	gasSchedule := wasmbinding.GasSchedule{
+		SubmitTxGas:                   1,
+		SubmitTxMsgGas:                10,
+		RegisterInterchainAccountGas:  100,
+		RegisterInterchainQueryGas:    1_000,
+		RegisterInterchainQueryKeyGas: 10_000,
+		UpdateInterchainQueryGas:      100_000,
+		UpdateInterchainQueryKeyGas:   1_000_000,
+		RemoveInterchainQueryGas:      10_000_000,
+		IBCTransferGas:                100_000_000,
+	}
+	keys := []*icqtypes.KVKey{{Path: host.StoreKey, Key: []byte("a")}, {Path: host.StoreKey, Key: []byte("b")}}
+
+	testCases := []struct {
+		title   string
+		msg     bindings.NeutronMsg
+		expCost uint64
+	}{
+		{
+			title:   "submit tx is charged per message",
+			msg:     bindings.NeutronMsg{SubmitTx: &bindings.SubmitTx{Msgs: make([]bindings.ProtobufAny, 3)}},
+			expCost: 31,
+		},
+		{
+			title:   "register interchain account",
+			msg:     bindings.NeutronMsg{RegisterInterchainAccount: &bindings.RegisterInterchainAccount{}},
+			expCost: 100,
+		},
+		{
+			title:   "register interchain query is charged per key",
+			msg:     bindings.NeutronMsg{RegisterInterchainQuery: &bindings.RegisterInterchainQuery{Keys: keys}},
+			expCost: 21_000,
+		},
+		{
+			title:   "update interchain query is charged per new key",
+			msg:     bindings.NeutronMsg{UpdateInterchainQuery: &bindings.UpdateInterchainQuery{NewKeys: keys}},
+			expCost: 2_100_000,
+		},
+		{
+			title:   "remove interchain query",
+			msg:     bindings.NeutronMsg{RemoveInterchainQuery: &bindings.RemoveInterchainQuery{}},
+			expCost: 10_000_000,
+		},
+		{
+			title:   "ibc transfer",
+			msg:     bindings.NeutronMsg{IBCTransfer: &bindings.IBCTransfer{}},
+			expCost: 100_000_000,
+		},
+		{
+			title: "batch is charged for each of its messages",
+			msg: bindings.NeutronMsg{Batch: &bindings.Batch{Msgs: []bindings.NeutronMsg{
+				{RegisterInterchainAccount: &bindings.RegisterInterchainAccount{}},
+				{RegisterInterchainQuery: &bindings.RegisterInterchainQuery{Keys: keys}},
+				{IBCTransfer: &bindings.IBCTransfer{}},
+			}}},
+			expCost: 100_021_100,
+		},
+		{
+			title:   "unknown message is not charged",
+			msg:     bindings.NeutronMsg{},
+			expCost: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			require.Equal(t, tc.expCost, gasSchedule.GasCost(tc.msg))
+		})
+	}
+}
+
+type GasScheduleTestSuite struct {
+	testutil.IBCConnectionTestSuite
+	neutron         *app.App
+	ctx             sdk.Context
+	messenger       *wasmbinding.CustomMessenger
+	contractOwner   sdk.AccAddress
+	contractAddress sdk.AccAddress
+}
+
+func (suite *GasScheduleTestSuite) SetupTest() {
+	suite.IBCConnectionTestSuite.SetupTest()
+	suite.neutron = suite.GetNeutronZoneApp(suite.ChainA)
+	suite.ctx = suite.ChainA.GetContext()
+
+	paramSpace := suite.neutron.ParamsKeeper.Subspace(wasmbinding.ParamsSubspace)
+	decorator := wasmbinding.CustomMessageDecorator(&suite.neutron.InterchainTxsKeeper, suite.neutron.ICAControllerKeeper, suite.neutron.IBCKeeper.ChannelKeeper, &suite.neutron.InterchainQueriesKeeper, suite.neutron.TransferKeeper, nil, nil, paramSpace, nil, nil, msgTypeFilter(nil))
+	suite.messenger = decorator(nil).(*wasmbinding.CustomMessenger)
+	suite.contractOwner = keeper.RandomAccountAddress(suite.T())
+
+	// Store code and instantiate reflect contract
+	codeId := suite.StoreReflectCode(suite.ctx, suite.contractOwner, "../testdata/reflect.wasm")
+	suite.contractAddress = suite.InstantiateReflectContract(suite.ctx, suite.contractOwner, codeId)
+	suite.Require().NotEmpty(suite.contractAddress)
+
+	// Top up contract balance for the query deposit
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	coinsAmnt := sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, sdk.NewInt(int64(10_000_000))))
+	suite.Require().NoError(suite.neutron.BankKeeper.SendCoins(suite.ctx, senderAddress, suite.contractAddress, coinsAmnt))
+}
+
+func (suite *GasScheduleTestSuite) TestDefaultGasSchedule() {
+	suite.Require().Equal(wasmbinding.DefaultGasSchedule(), suite.messenger.GetGasSchedule(suite.ctx))
+
+	gasSchedule := wasmbinding.DefaultGasSchedule()
+	gasSchedule.IBCTransferGas = 5_000
+	paramSpace, _ := suite.neutron.ParamsKeeper.GetSubspace(wasmbinding.ParamsSubspace)
+	paramSpace.SetParamSet(suite.ctx, &gasSchedule)
+	suite.Require().Equal(gasSchedule, suite.messenger.GetGasSchedule(suite.ctx))
+}
+
+func (suite *GasScheduleTestSuite) TestRegisterInterchainQueryCharged() {
+	msg := bindings.NeutronMsg{
+		RegisterInterchainQuery: &bindings.RegisterInterchainQuery{
+			QueryType: string(icqtypes.InterchainQueryTypeKV),
+			Keys: []*icqtypes.KVKey{
+				{Path: host.StoreKey, Key: host.FullClientStateKey(suite.Path.EndpointB.ClientID)},
+				{Path: host.StoreKey, Key: host.FullConsensusStateKey(suite.Path.EndpointB.ClientID, suite.Path.EndpointB.GetClientState().GetLatestHeight())},
+			},
+			TransactionsFilter: "{}",
+			ConnectionId:       suite.Path.EndpointA.ConnectionID,
+			UpdatePeriod:       20,
+		},
+	}
+	bz, err := json.Marshal(msg)
+	suite.Require().NoError(err)
+	cost := wasmbinding.DefaultGasSchedule().GasCost(msg)
+	suite.Require().Equal(2*wasmbinding.DefaultGasSchedule().RegisterInterchainQueryKeyGas, cost)
+
+	// the gas is charged before the query is registered
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(cost))
+	suite.Require().PanicsWithValue(sdk.ErrorOutOfGas{Descriptor: "custom message"}, func() {
+		_, _, _ = suite.messenger.DispatchMsg(ctx, suite.contractAddress, suite.Path.EndpointA.ChannelConfig.PortID, types.CosmosMsg{Custom: bz})
+	})
+	suite.Require().Equal(uint64(0), suite.neutron.InterchainQueriesKeeper.GetLastRegisteredQueryKey(ctx.WithGasMeter(sdk.NewInfiniteGasMeter())))
+
+	ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, data, err := suite.messenger.DispatchMsg(ctx, suite.contractAddress, suite.Path.EndpointA.ChannelConfig.PortID, types.CosmosMsg{Custom: bz})
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]byte{[]byte(`{"id":1}`)}, data)
+	suite.Require().Greater(ctx.GasMeter().GasConsumed(), cost)
+}
+
+func TestGasScheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(GasScheduleTestSuite))
+}
@@ -0,0 +1,149 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+)
+
+func (m *CustomMessenger) createDenom(ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *bindings.CreateDenom) ([]sdk.Event, [][]byte, error) {
+	denom, err := m.tokenFactoryKeeper.CreateDenom(ctx, contractAddr, createDenom.Subdenom)
+	if err != nil {
+		ctx.Logger().Debug("CreateDenom: failed to create denom",
+			"from_address", contractAddr.String(),
+			"msg", createDenom,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to create denom")
+	}
+
+	data, err := json.Marshal(bindings.CreateDenomResponse{NewTokenDenom: denom})
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal CreateDenomResponse response to JSON",
+			"from_address", contractAddr.String(),
+			"msg", createDenom,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("denom created",
+		"from_address", contractAddr.String(),
+		"denom", denom,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) mint(ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindings.MintTokens) ([]sdk.Event, [][]byte, error) {
+	if err := m.performMint(ctx, contractAddr, mint); err != nil {
+		ctx.Logger().Debug("performMint: failed to mint tokens",
+			"from_address", contractAddr.String(),
+			"msg", mint,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to mint tokens")
+	}
+
+	return nil, nil, nil
+}
+
+func (m *CustomMessenger) performMint(ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindings.MintTokens) error {
+	amount, err := newFactoryCoin(mint.Denom, mint.Amount)
+	if err != nil {
+		return err
+	}
+
+	mintTo := contractAddr
+	if mint.MintToAddress != "" {
+		if mintTo, err = sdk.AccAddressFromBech32(mint.MintToAddress); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	return m.tokenFactoryKeeper.Mint(ctx, contractAddr, amount, mintTo)
+}
+
+func (m *CustomMessenger) burn(ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindings.BurnTokens) ([]sdk.Event, [][]byte, error) {
+	if err := m.performBurn(ctx, contractAddr, burn); err != nil {
+		ctx.Logger().Debug("performBurn: failed to burn tokens",
+			"from_address", contractAddr.String(),
+			"msg", burn,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to burn tokens")
+	}
+
+	return nil, nil, nil
+}
+
+func (m *CustomMessenger) performBurn(ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindings.BurnTokens) error {
+	amount, err := newFactoryCoin(burn.Denom, burn.Amount)
+	if err != nil {
+		return err
+	}
+
+	return m.tokenFactoryKeeper.Burn(ctx, contractAddr, amount)
+}
+
+func (m *CustomMessenger) changeAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindings.ChangeAdmin) ([]sdk.Event, [][]byte, error) {
+	if err := m.tokenFactoryKeeper.ChangeAdmin(ctx, contractAddr, changeAdmin.Denom, changeAdmin.NewAdminAddress); err != nil {
+		ctx.Logger().Debug("ChangeAdmin: failed to change the denom admin",
+			"from_address", contractAddr.String(),
+			"msg", changeAdmin,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to change the denom admin")
+	}
+
+	return nil, nil, nil
+}
+
+func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddress, setMetadata *bindings.SetMetadata) ([]sdk.Event, [][]byte, error) {
+	if err := m.tokenFactoryKeeper.SetMetadata(ctx, contractAddr, getBankMetadata(setMetadata.Metadata)); err != nil {
+		ctx.Logger().Debug("SetMetadata: failed to set the denom metadata",
+			"from_address", contractAddr.String(),
+			"msg", setMetadata,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to set the denom metadata")
+	}
+
+	return nil, nil, nil
+}
+
+func newFactoryCoin(denom string, amount sdk.Int) (sdk.Coin, error) {
+	if amount.IsNil() || !amount.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount of %s must be positive", denom)
+	}
+
+	coin := sdk.Coin{Denom: denom, Amount: amount}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return coin, nil
+}
+
+func getBankMetadata(metadata bindings.Metadata) banktypes.Metadata {
+	units := make([]*banktypes.DenomUnit, 0, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		units = append(units, &banktypes.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		})
+	}
+
+	return banktypes.Metadata{
+		Description: metadata.Description,
+		DenomUnits:  units,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+	}
+}
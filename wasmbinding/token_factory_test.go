@@ -0,0 +1,71 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func TestTokenFactoryMessages(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	app.TokenFactoryKeeper.SetParams(ctx, tokenfactorytypes.DefaultParams())
+	messenger := wasmbinding.CustomMessageDecorator(&app.InterchainTxsKeeper, app.ICAControllerKeeper, app.IBCKeeper.ChannelKeeper, &app.InterchainQueriesKeeper, app.TransferKeeper, &app.ContractTransfersKeeper, &app.ContractFailuresKeeper,
+		app.GetSubspace(wasmbinding.ParamsSubspace), &app.GovKeeper, &app.TokenFactoryKeeper, &app.TaxKeeper)(nil).(*wasmbinding.CustomMessenger)
+
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	dispatch := func(sender sdk.AccAddress, msg bindings.NeutronMsg) ([][]byte, error) {
+		bz, err := json.Marshal(msg)
+		require.NoError(t, err)
+
+		_, data, err := messenger.DispatchMsg(ctx, sender, "", types.CosmosMsg{Custom: bz})
+		return data, err
+	}
+
+	data, err := dispatch(contract, bindings.NeutronMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "lpn"}})
+	require.NoError(t, err)
+	denom := "factory/" + contract.String() + "/lpn"
+	require.Equal(t, [][]byte{[]byte(`{"new_token_denom":"` + denom + `"}`)}, data)
+
+	_, err = dispatch(contract, bindings.NeutronMsg{Mint: &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(100)}})
+	require.NoError(t, err)
+	_, err = dispatch(contract, bindings.NeutronMsg{Mint: &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(50), MintToAddress: holder.String()}})
+	require.NoError(t, err)
+	_, err = dispatch(contract, bindings.NeutronMsg{Mint: &bindings.MintTokens{Denom: denom, Amount: sdk.ZeroInt()}})
+	require.Error(t, err)
+	_, err = dispatch(holder, bindings.NeutronMsg{Mint: &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(1)}})
+	require.ErrorIs(t, err, tokenfactorytypes.ErrUnauthorized)
+	require.Equal(t, sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetBalance(ctx, contract, denom))
+	require.Equal(t, sdk.NewInt64Coin(denom, 50), app.BankKeeper.GetBalance(ctx, holder, denom))
+
+	_, err = dispatch(contract, bindings.NeutronMsg{Burn: &bindings.BurnTokens{Denom: denom, Amount: sdk.NewInt(40)}})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom, 60), app.BankKeeper.GetBalance(ctx, contract, denom))
+
+	_, err = dispatch(contract, bindings.NeutronMsg{SetMetadata: &bindings.SetMetadata{Metadata: bindings.Metadata{
+		DenomUnits: []bindings.DenomUnit{{Denom: denom}, {Denom: "lpn", Exponent: 6}},
+		Base:       denom,
+		Display:    "lpn",
+		Name:       "lpn",
+		Symbol:     "LPN",
+	}}})
+	require.NoError(t, err)
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, "LPN", metadata.Symbol)
+
+	_, err = dispatch(contract, bindings.NeutronMsg{ChangeAdmin: &bindings.ChangeAdmin{Denom: denom, NewAdminAddress: holder.String()}})
+	require.NoError(t, err)
+	authority, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, holder.String(), authority.Admin)
+}
@@ -3,25 +3,47 @@ package wasmbinding
 import (
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	interchainqueriesmodulekeeper "github.com/neutron-org/neutron/x/interchainqueries/keeper"
 	interchaintransactionsmodulekeeper "github.com/neutron-org/neutron/x/interchaintxs/keeper"
 	transfer "github.com/neutron-org/neutron/x/transfer/keeper"
+
+	contractfailureskeeper "github.com/Nolus-Protocol/nolus-core/x/contractfailures/keeper"
+	contracttransferskeeper "github.com/Nolus-Protocol/nolus-core/x/contracttransfers/keeper"
+	mintkeeper "github.com/Nolus-Protocol/nolus-core/x/mint/keeper"
+	taxkeeper "github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+	tokenfactorykeeper "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
 )
 
 // RegisterCustomPlugins returns wasmkeeper.Option that we can use to connect handlers for implemented custom queries and messages to the App.
 func RegisterCustomPlugins(
 	ictxKeeper *interchaintransactionsmodulekeeper.Keeper,
+	icaControllerKeeper ICAControllerKeeper,
+	channelKeeper ChannelKeeper,
 	icqKeeper *interchainqueriesmodulekeeper.Keeper,
 	transfer transfer.KeeperTransferWrapper,
+	contractTransfersKeeper *contracttransferskeeper.Keeper,
+	contractFailuresKeeper *contractfailureskeeper.Keeper,
+	paramSpace paramtypes.Subspace,
+	govKeeper *govkeeper.Keeper,
+	mintKeeper *mintkeeper.Keeper,
+	taxKeeper *taxkeeper.Keeper,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+	historicalInfoKeeper HistoricalInfoKeeper,
+	queryRouter wasmkeeper.GRPCQueryRouter,
+	cdc codec.Codec,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(ictxKeeper, icqKeeper)
+	wasmQueryPlugin := NewQueryPlugin(ictxKeeper, icqKeeper, mintKeeper, taxKeeper, contractTransfersKeeper, historicalInfoKeeper)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
-		Custom: CustomQuerier(wasmQueryPlugin),
+		Custom:   CustomQuerier(wasmQueryPlugin),
+		Stargate: StargateQuerier(paramSpace, queryRouter, cdc),
 	})
 	messageHandlerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(ictxKeeper, icqKeeper, transfer),
+		CustomMessageDecorator(ictxKeeper, icaControllerKeeper, channelKeeper, icqKeeper, transfer, contractTransfersKeeper, contractFailuresKeeper, paramSpace, govKeeper, tokenFactoryKeeper, taxKeeper),
 	)
 
 	return []wasm.Option{
@@ -0,0 +1,37 @@
+package blocklist
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankAppModule is the bank module serving its messages through the restricted bank keeper.
+// The bank module itself casts its keeper to the base one when registering the services.
+type BankAppModule struct {
+	bank.AppModule
+
+	keeper keeper.RestrictedBankKeeper
+}
+
+// NewBankAppModule creates a new bank module consulting the blocklist on MsgSend and MsgMultiSend.
+func NewBankAppModule(cdc codec.Codec, k keeper.RestrictedBankKeeper, accountKeeper banktypes.AccountKeeper) BankAppModule {
+	return BankAppModule{
+		AppModule: bank.NewAppModule(cdc, k.BaseKeeper, accountKeeper),
+		keeper:    k,
+	}
+}
+
+// RegisterServices registers the bank services with the restricted keeper as message server.
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+)
+
+const (
+	FlagAddAddresses     = "add-addresses"
+	FlagRemoveAddresses  = "remove-addresses"
+	FlagAddIBCSenders    = "add-ibc-senders"
+	FlagRemoveIBCSenders = "remove-ibc-senders"
+)
+
+// NewCmdSubmitUpdateBlocklistProposal implements a command handler for submitting a blocklist update proposal.
+func NewCmdSubmitUpdateBlocklistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-blocklist",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to update the blocklist",
+		Long: "Submit a proposal to update the blocklist along with an initial deposit.\n" +
+			"The Nolus addresses are given in bech32 format, the IBC senders in the format of the counterparty chain.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			addAddresses, err := cmd.Flags().GetStringSlice(FlagAddAddresses)
+			if err != nil {
+				return err
+			}
+
+			removeAddresses, err := cmd.Flags().GetStringSlice(FlagRemoveAddresses)
+			if err != nil {
+				return err
+			}
+
+			addIBCSenders, err := cmd.Flags().GetStringSlice(FlagAddIBCSenders)
+			if err != nil {
+				return err
+			}
+
+			removeIBCSenders, err := cmd.Flags().GetStringSlice(FlagRemoveIBCSenders)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateBlocklistProposal(title, description, addAddresses, removeAddresses, addIBCSenders, removeIBCSenders)
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().StringSlice(FlagAddAddresses, []string{}, "comma separated Nolus addresses to block")
+	cmd.Flags().StringSlice(FlagRemoveAddresses, []string{}, "comma separated Nolus addresses to unblock")
+	cmd.Flags().StringSlice(FlagAddIBCSenders, []string{}, "comma separated IBC senders to block")
+	cmd.Flags().StringSlice(FlagRemoveIBCSenders, []string{}, "comma separated IBC senders to unblock")
+
+	return cmd
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/client/cli"
+)
+
+// UpdateBlocklistProposalHandler is the blocklist update proposal handler.
+var UpdateBlocklistProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateBlocklistProposal, emptyRestHandler)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-blocklist",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for blocklist proposals")
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package blocklist
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the blocklist module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, addr := range genState.BlockedAddresses {
+		k.BlockAddress(ctx, sdk.MustAccAddressFromBech32(addr))
+	}
+
+	for _, sender := range genState.BlockedIbcSenders {
+		k.BlockIBCSender(ctx, sender)
+	}
+}
+
+// ExportGenesis returns the blocklist module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetBlockedAddresses(ctx), k.GetBlockedIBCSenders(ctx))
+}
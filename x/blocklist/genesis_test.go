@@ -0,0 +1,28 @@
+package blocklist_test
+
+import (
+	"testing"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestGenesis(t *testing.T) {
+	params.SetAddressPrefixes()
+	blocked := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	genesisState := *types.NewGenesisState([]string{blocked.String()}, []string{"cosmos1blocked"})
+
+	k, ctx := keepertest.BlocklistKeeper(t)
+	blocklist.InitGenesis(ctx, *k, genesisState)
+	require.True(t, k.IsAddressBlocked(ctx, blocked))
+	require.True(t, k.IsIBCSenderBlocked(ctx, "cosmos1blocked"))
+
+	got := blocklist.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
+}
@@ -0,0 +1,59 @@
+package blocklist_test
+
+import (
+	"testing"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// recvApp records the packets passed through by the middleware.
+type recvApp struct {
+	porttypes.IBCModule
+
+	received int
+}
+
+func (a *recvApp) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	a.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestIBCMiddlewareOnRecvPacket(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx := keepertest.BlocklistKeeper(t)
+	app := &recvApp{}
+	middleware := blocklist.NewIBCMiddleware(app, *k)
+
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	packet := func(sender string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, receiver.String())
+		return channeltypes.Packet{Data: data.GetBytes()}
+	}
+
+	ack := middleware.OnRecvPacket(ctx, packet("cosmos1sender"), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, 1, app.received)
+
+	k.BlockIBCSender(ctx, "cosmos1sender")
+	ack = middleware.OnRecvPacket(ctx, packet("cosmos1sender"), nil)
+	require.False(t, ack.Success())
+	require.Equal(t, 1, app.received)
+
+	ack = middleware.OnRecvPacket(ctx, packet("cosmos1other"), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, 2, app.received)
+
+	k.BlockAddress(ctx, receiver)
+	ack = middleware.OnRecvPacket(ctx, packet("cosmos1other"), nil)
+	require.False(t, ack.Success())
+	require.Equal(t, 2, app.received)
+}
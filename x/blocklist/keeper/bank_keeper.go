@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ bankkeeper.Keeper = RestrictedBankKeeper{}
+
+// RestrictedBankKeeper wraps the bank keeper and rejects the transfers initiated by
+// or sent to a blocked address. Module payouts to accounts are not restricted so that
+// a blocked address can never make an EndBlocker fail; the incoming IBC transfers are
+// guarded by the IBC middleware instead.
+type RestrictedBankKeeper struct {
+	bankkeeper.BaseKeeper
+
+	blocklist Keeper
+}
+
+// NewRestrictedBankKeeper returns a bank keeper consulting the blocklist before any transfer.
+func NewRestrictedBankKeeper(bk bankkeeper.BaseKeeper, blocklist Keeper) RestrictedBankKeeper {
+	return RestrictedBankKeeper{BaseKeeper: bk, blocklist: blocklist}
+}
+
+// SendCoins transfers amt coins from a sending account to a receiving account
+// if neither of them is blocked.
+func (k RestrictedBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blocklist.CheckAddresses(ctx, fromAddr, toAddr); err != nil {
+		return err
+	}
+
+	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins performs multi-send functionality if none of the inputs and outputs is blocked.
+func (k RestrictedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, in := range inputs {
+		if err := k.checkBech32(ctx, in.Address); err != nil {
+			return err
+		}
+	}
+	for _, out := range outputs {
+		if err := k.checkBech32(ctx, out.Address); err != nil {
+			return err
+		}
+	}
+
+	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromAccountToModule transfers coins from an account to a module account
+// if the account is not blocked.
+func (k RestrictedBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.blocklist.CheckAddresses(ctx, senderAddr); err != nil {
+		return err
+	}
+
+	return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// DelegateCoins performs delegation by deducting amt coins from an account with address addr
+// if the account is not blocked.
+func (k RestrictedBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blocklist.CheckAddresses(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	return k.BaseKeeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+}
+
+// DelegateCoinsFromAccountToModule delegates coins from an account to a module account
+// if the account is not blocked.
+func (k RestrictedBankKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.blocklist.CheckAddresses(ctx, senderAddr); err != nil {
+		return err
+	}
+
+	return k.BaseKeeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+func (k RestrictedBankKeeper) checkBech32(ctx sdk.Context, bech32Addr string) error {
+	addr, err := sdk.AccAddressFromBech32(bech32Addr)
+	if err != nil {
+		return err
+	}
+
+	return k.blocklist.CheckAddresses(ctx, addr)
+}
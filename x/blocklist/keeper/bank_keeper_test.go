@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+)
+
+func TestRestrictedBankKeeper(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	bk := app.BankKeeper
+
+	alice := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bob := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("unls", 100))
+	require.NoError(t, simapp.FundAccount(bk, ctx, alice, coins.Add(coins...)))
+
+	require.NoError(t, bk.SendCoins(ctx, alice, bob, coins))
+
+	app.BlocklistKeeper.BlockAddress(ctx, bob)
+	require.ErrorIs(t, bk.SendCoins(ctx, alice, bob, coins), types.ErrBlockedAddress)
+	require.ErrorIs(t, bk.SendCoins(ctx, bob, alice, coins), types.ErrBlockedAddress)
+	require.ErrorIs(t, bk.SendCoinsFromAccountToModule(ctx, bob, banktypes.ModuleName, coins), types.ErrBlockedAddress)
+	require.ErrorIs(t, bk.DelegateCoinsFromAccountToModule(ctx, bob, stakingtypes.NotBondedPoolName, coins), types.ErrBlockedAddress)
+	require.ErrorIs(t, bk.InputOutputCoins(ctx,
+		[]banktypes.Input{banktypes.NewInput(alice, coins)},
+		[]banktypes.Output{banktypes.NewOutput(bob, coins)},
+	), types.ErrBlockedAddress)
+
+	app.BlocklistKeeper.UnblockAddress(ctx, bob)
+	require.NoError(t, bk.SendCoins(ctx, bob, alice, coins))
+	require.Equal(t, coins.Add(coins...), bk.GetAllBalances(ctx, alice))
+}
+
+func TestBlockedAddressContractFunds(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	app.WasmKeeper.SetParams(ctx, wasmtypes.DefaultParams())
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper)
+
+	alice := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("unls", 100))
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, alice, coins.Add(coins...)))
+
+	wasmCode, err := os.ReadFile("../../../wasmbinding/testdata/reflect.wasm")
+	require.NoError(t, err)
+	codeID, _, err := contractKeeper.Create(ctx, alice, wasmCode, nil)
+	require.NoError(t, err)
+
+	// the contracts move the funds with the restricted bank keeper too
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, alice, alice, []byte("{}"), "reflect", coins)
+	require.NoError(t, err)
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, contract))
+
+	app.BlocklistKeeper.BlockAddress(ctx, alice)
+	_, _, err = contractKeeper.Instantiate(ctx, codeID, alice, alice, []byte("{}"), "reflect", coins)
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, alice))
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// blockedMarker is the value stored under the key of every blocked entry.
+var blockedMarker = []byte{0x01}
+
+// BlockAddress adds a Nolus address to the blocklist.
+func (k Keeper) BlockAddress(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.BlockedAddressKey(addr), blockedMarker)
+}
+
+// UnblockAddress removes a Nolus address from the blocklist.
+func (k Keeper) UnblockAddress(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.BlockedAddressKey(addr))
+}
+
+// IsAddressBlocked returns true if the Nolus address is not allowed to send or receive tokens.
+func (k Keeper) IsAddressBlocked(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.BlockedAddressKey(addr))
+}
+
+// GetBlockedAddresses returns the bech32 form of all blocked Nolus addresses.
+func (k Keeper) GetBlockedAddresses(ctx sdk.Context) []string {
+	addrs := []string{}
+	k.iterate(ctx, types.BlockedAddressPrefix, func(key []byte) {
+		addrs = append(addrs, sdk.AccAddress(key).String())
+	})
+
+	return addrs
+}
+
+// BlockIBCSender adds the sender of incoming IBC transfers to the blocklist.
+func (k Keeper) BlockIBCSender(ctx sdk.Context, sender string) {
+	ctx.KVStore(k.storeKey).Set(types.BlockedIBCSenderKey(sender), blockedMarker)
+}
+
+// UnblockIBCSender removes the sender of incoming IBC transfers from the blocklist.
+func (k Keeper) UnblockIBCSender(ctx sdk.Context, sender string) {
+	ctx.KVStore(k.storeKey).Delete(types.BlockedIBCSenderKey(sender))
+}
+
+// IsIBCSenderBlocked returns true if the incoming IBC transfers of the sender are rejected.
+func (k Keeper) IsIBCSenderBlocked(ctx sdk.Context, sender string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.BlockedIBCSenderKey(sender))
+}
+
+// GetBlockedIBCSenders returns all blocked senders of incoming IBC transfers.
+func (k Keeper) GetBlockedIBCSenders(ctx sdk.Context) []string {
+	senders := []string{}
+	k.iterate(ctx, types.BlockedIBCSenderPrefix, func(key []byte) {
+		senders = append(senders, string(key))
+	})
+
+	return senders
+}
+
+// CheckAddresses returns an error if any of the addresses is blocked.
+func (k Keeper) CheckAddresses(ctx sdk.Context, addrs ...sdk.AccAddress) error {
+	for _, addr := range addrs {
+		if k.IsAddressBlocked(ctx, addr) {
+			return sdkerrors.Wrap(types.ErrBlockedAddress, addr.String())
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) iterate(ctx sdk.Context, keyPrefix []byte, cb func(key []byte)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		cb(iterator.Key())
+	}
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestBlockedAddresses(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx := keepertest.BlocklistKeeper(t)
+
+	blocked := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	require.False(t, k.IsAddressBlocked(ctx, blocked))
+	require.Empty(t, k.GetBlockedAddresses(ctx))
+
+	k.BlockAddress(ctx, blocked)
+	require.True(t, k.IsAddressBlocked(ctx, blocked))
+	require.False(t, k.IsAddressBlocked(ctx, other))
+	require.Equal(t, []string{blocked.String()}, k.GetBlockedAddresses(ctx))
+
+	require.NoError(t, k.CheckAddresses(ctx, other))
+	require.ErrorIs(t, k.CheckAddresses(ctx, other, blocked), types.ErrBlockedAddress)
+
+	k.UnblockAddress(ctx, blocked)
+	require.False(t, k.IsAddressBlocked(ctx, blocked))
+	require.NoError(t, k.CheckAddresses(ctx, other, blocked))
+	require.Empty(t, k.GetBlockedAddresses(ctx))
+}
+
+func TestBlockedIBCSenders(t *testing.T) {
+	k, ctx := keepertest.BlocklistKeeper(t)
+
+	const sender = "osmo1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	require.False(t, k.IsIBCSenderBlocked(ctx, sender))
+
+	k.BlockIBCSender(ctx, sender)
+	require.True(t, k.IsIBCSenderBlocked(ctx, sender))
+	require.False(t, k.IsIBCSenderBlocked(ctx, "osmo1other"))
+	require.Equal(t, []string{sender}, k.GetBlockedIBCSenders(ctx))
+	require.Empty(t, k.GetBlockedAddresses(ctx))
+
+	k.UnblockIBCSender(ctx, sender)
+	require.False(t, k.IsIBCSenderBlocked(ctx, sender))
+	require.Empty(t, k.GetBlockedIBCSenders(ctx))
+}
+
+func TestGRPCQueryBlocklist(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx := keepertest.BlocklistKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	blocked := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	k.BlockAddress(ctx, blocked)
+	k.BlockIBCSender(ctx, "0xd882cfc20f52f2599d84b8e8d58c7fb62cfe344b")
+
+	res, err := k.Blocklist(wctx, &types.QueryBlocklistRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBlocklistResponse{
+		BlockedAddresses:  []string{blocked.String()},
+		BlockedIbcSenders: []string{"0xd882cfc20f52f2599d84b8e8d58c7fb62cfe344b"},
+	}, res)
+
+	_, err = k.Blocklist(wctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Blocklist(c context.Context, req *types.QueryBlocklistRequest) (*types.QueryBlocklistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBlocklistResponse{
+		BlockedAddresses:  k.GetBlockedAddresses(ctx),
+		BlockedIbcSenders: k.GetBlockedIBCSenders(ctx),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package blocklist
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewBlocklistProposalHandler creates a governance handler to manage the blocklist.
+func NewBlocklistProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateBlocklistProposal:
+			return handleUpdateBlocklistProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized blocklist proposal content type: %T", c)
+		}
+	}
+}
+
+func handleUpdateBlocklistProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateBlocklistProposal) error {
+	attrs := make([]sdk.Attribute, 0, len(p.AddAddresses)+len(p.RemoveAddresses)+len(p.AddIbcSenders)+len(p.RemoveIbcSenders))
+
+	for _, bech32Addr := range p.AddAddresses {
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "%s: %s", bech32Addr, err)
+		}
+		k.BlockAddress(ctx, addr)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyBlockedAddress, bech32Addr))
+	}
+
+	for _, bech32Addr := range p.RemoveAddresses {
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "%s: %s", bech32Addr, err)
+		}
+		k.UnblockAddress(ctx, addr)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyUnblockedAddress, bech32Addr))
+	}
+
+	for _, sender := range p.AddIbcSenders {
+		k.BlockIBCSender(ctx, sender)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyBlockedSender, sender))
+	}
+
+	for _, sender := range p.RemoveIbcSenders {
+		k.UnblockIBCSender(ctx, sender)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyUnblockedSender, sender))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUpdateBlocklist, attrs...))
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package blocklist_test
+
+import (
+	"testing"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestUpdateBlocklistProposal(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx := keepertest.BlocklistKeeper(t)
+	handler := blocklist.NewBlocklistProposalHandler(*k)
+
+	first := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	second := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	p := types.NewUpdateBlocklistProposal("title", "description",
+		[]string{first.String(), second.String()}, nil, []string{"osmo1sender"}, nil)
+	require.NoError(t, p.ValidateBasic())
+	require.NoError(t, handler(ctx, p))
+	require.True(t, k.IsAddressBlocked(ctx, first))
+	require.True(t, k.IsAddressBlocked(ctx, second))
+	require.True(t, k.IsIBCSenderBlocked(ctx, "osmo1sender"))
+
+	p = types.NewUpdateBlocklistProposal("title", "description",
+		nil, []string{first.String()}, nil, []string{"osmo1sender"})
+	require.NoError(t, handler(ctx, p))
+	require.False(t, k.IsAddressBlocked(ctx, first))
+	require.True(t, k.IsAddressBlocked(ctx, second))
+	require.False(t, k.IsIBCSenderBlocked(ctx, "osmo1sender"))
+
+	require.Error(t, handler(ctx, govtypes.NewTextProposal("title", "description")))
+}
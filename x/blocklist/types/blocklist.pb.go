@@ -0,0 +1,557 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blocklist/blocklist.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdateBlocklistProposal is a governance proposal to update the blocklist.
+type UpdateBlocklistProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// add_addresses are the Nolus addresses to block.
+	AddAddresses []string `protobuf:"bytes,3,rep,name=add_addresses,json=addAddresses,proto3" json:"add_addresses,omitempty"`
+	// remove_addresses are the Nolus addresses to unblock.
+	RemoveAddresses []string `protobuf:"bytes,4,rep,name=remove_addresses,json=removeAddresses,proto3" json:"remove_addresses,omitempty"`
+	// add_ibc_senders are the senders of the incoming IBC transfers to reject, in the format of the counterparty chain.
+	AddIbcSenders []string `protobuf:"bytes,5,rep,name=add_ibc_senders,json=addIbcSenders,proto3" json:"add_ibc_senders,omitempty"`
+	// remove_ibc_senders are the senders of the incoming IBC transfers to accept again.
+	RemoveIbcSenders []string `protobuf:"bytes,6,rep,name=remove_ibc_senders,json=removeIbcSenders,proto3" json:"remove_ibc_senders,omitempty"`
+}
+
+func (m *UpdateBlocklistProposal) Reset()      { *m = UpdateBlocklistProposal{} }
+func (*UpdateBlocklistProposal) ProtoMessage() {}
+func (*UpdateBlocklistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b80cf89291abc7f, []int{0}
+}
+func (m *UpdateBlocklistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateBlocklistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateBlocklistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateBlocklistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateBlocklistProposal.Merge(m, src)
+}
+func (m *UpdateBlocklistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateBlocklistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateBlocklistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateBlocklistProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdateBlocklistProposal)(nil), "blocklist.UpdateBlocklistProposal")
+}
+
+func init() { proto.RegisterFile("blocklist/blocklist.proto", fileDescriptor_7b80cf89291abc7f) }
+
+var fileDescriptor_7b80cf89291abc7f = []byte{
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4b, 0xfb, 0x40,
+	0x14, 0xc7, 0x93, 0xf6, 0xd7, 0xf2, 0xeb, 0xa9, 0x54, 0x8e, 0x82, 0xd1, 0xe1, 0x5a, 0x14, 0xa4,
+	0x82, 0xf6, 0x06, 0xc1, 0xc1, 0xcd, 0x6e, 0x2e, 0x52, 0x2a, 0x2e, 0x2e, 0x25, 0xb9, 0x3b, 0xe2,
+	0xe1, 0x35, 0x2f, 0xdc, 0x5d, 0x45, 0x47, 0x37, 0x47, 0x47, 0xc7, 0xfe, 0x39, 0x8e, 0x1d, 0x1d,
+	0x25, 0xf9, 0x47, 0x24, 0x97, 0xb6, 0xc9, 0xf6, 0xde, 0xe7, 0x7d, 0x78, 0x5f, 0xf8, 0xa2, 0xc3,
+	0x48, 0x01, 0x7b, 0x56, 0xd2, 0x58, 0xba, 0x9d, 0x46, 0xa9, 0x06, 0x0b, 0xb8, 0xb3, 0x05, 0x47,
+	0xbd, 0x18, 0x62, 0x70, 0x94, 0x16, 0x53, 0x29, 0x1c, 0xbf, 0x37, 0xd0, 0xc1, 0x43, 0xca, 0x43,
+	0x2b, 0xc6, 0x1b, 0x73, 0xa2, 0x21, 0x05, 0x13, 0x2a, 0xdc, 0x43, 0x2d, 0x2b, 0xad, 0x12, 0x81,
+	0x3f, 0xf0, 0x87, 0x9d, 0x69, 0xb9, 0xe0, 0x01, 0xda, 0xe1, 0xc2, 0x30, 0x2d, 0x53, 0x2b, 0x21,
+	0x09, 0x1a, 0xee, 0x56, 0x47, 0xf8, 0x04, 0xed, 0x85, 0x9c, 0xcf, 0x42, 0xce, 0xb5, 0x30, 0x46,
+	0x98, 0xa0, 0x39, 0x68, 0x0e, 0x3b, 0xd3, 0xdd, 0x90, 0xf3, 0x9b, 0x0d, 0xc3, 0x67, 0x68, 0x5f,
+	0x8b, 0x39, 0xbc, 0x88, 0x9a, 0xf7, 0xcf, 0x79, 0xdd, 0x92, 0x57, 0xea, 0x29, 0xea, 0x16, 0xff,
+	0x64, 0xc4, 0x66, 0x46, 0x24, 0x5c, 0x68, 0x13, 0xb4, 0x9c, 0x59, 0xc4, 0xdc, 0x46, 0xec, 0xbe,
+	0x84, 0xf8, 0x1c, 0xe1, 0xf5, 0xcb, 0xba, 0xda, 0x76, 0xea, 0x3a, 0xac, 0xb2, 0xaf, 0xff, 0x7f,
+	0x2c, 0xfb, 0xde, 0xd7, 0xb2, 0xef, 0x8d, 0x27, 0xdf, 0x19, 0xf1, 0x57, 0x19, 0xf1, 0x7f, 0x33,
+	0xe2, 0x7f, 0xe6, 0xc4, 0x5b, 0xe5, 0xc4, 0xfb, 0xc9, 0x89, 0xf7, 0x78, 0x15, 0x4b, 0xfb, 0xb4,
+	0x88, 0x46, 0x0c, 0xe6, 0xf4, 0x0e, 0xd4, 0xc2, 0x5c, 0x4c, 0x8a, 0xd6, 0x18, 0x28, 0x9a, 0xb8,
+	0x95, 0x81, 0x16, 0xf4, 0xb5, 0xaa, 0x9d, 0xda, 0xb7, 0x54, 0x98, 0xa8, 0xed, 0xca, 0xbd, 0xfc,
+	0x1b, 0x00, 0x3a, 0x97, 0x37, 0x9d, 0x9a, 0x01, 0x00, 0x00,
+}
+
+func (m *UpdateBlocklistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateBlocklistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateBlocklistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveIbcSenders) > 0 {
+		for iNdEx := len(m.RemoveIbcSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveIbcSenders[iNdEx])
+			copy(dAtA[i:], m.RemoveIbcSenders[iNdEx])
+			i = encodeVarintBlocklist(dAtA, i, uint64(len(m.RemoveIbcSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AddIbcSenders) > 0 {
+		for iNdEx := len(m.AddIbcSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddIbcSenders[iNdEx])
+			copy(dAtA[i:], m.AddIbcSenders[iNdEx])
+			i = encodeVarintBlocklist(dAtA, i, uint64(len(m.AddIbcSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
+			i = encodeVarintBlocklist(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddAddresses) > 0 {
+		for iNdEx := len(m.AddAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddAddresses[iNdEx])
+			copy(dAtA[i:], m.AddAddresses[iNdEx])
+			i = encodeVarintBlocklist(dAtA, i, uint64(len(m.AddAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBlocklist(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBlocklist(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlocklist(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlocklist(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateBlocklistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBlocklist(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBlocklist(uint64(l))
+	}
+	if len(m.AddAddresses) > 0 {
+		for _, s := range m.AddAddresses {
+			l = len(s)
+			n += 1 + l + sovBlocklist(uint64(l))
+		}
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovBlocklist(uint64(l))
+		}
+	}
+	if len(m.AddIbcSenders) > 0 {
+		for _, s := range m.AddIbcSenders {
+			l = len(s)
+			n += 1 + l + sovBlocklist(uint64(l))
+		}
+	}
+	if len(m.RemoveIbcSenders) > 0 {
+		for _, s := range m.RemoveIbcSenders {
+			l = len(s)
+			n += 1 + l + sovBlocklist(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBlocklist(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlocklist(x uint64) (n int) {
+	return sovBlocklist(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateBlocklistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocklist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateBlocklistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateBlocklistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAddresses = append(m.AddAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddIbcSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddIbcSenders = append(m.AddIbcSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveIbcSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveIbcSenders = append(m.RemoveIbcSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocklist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlocklist(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlocklist
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlocklist
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlocklist
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlocklist
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlocklist        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlocklist          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlocklist = fmt.Errorf("proto: unexpected end of group")
+)
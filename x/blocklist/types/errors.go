@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/blocklist module sentinel errors.
+var (
+	ErrBlockedAddress   = sdkerrors.Register(ModuleName, 1, "address is blocked")
+	ErrBlockedIBCSender = sdkerrors.Register(ModuleName, 2, "IBC sender is blocked")
+	ErrInvalidAddress   = sdkerrors.Register(ModuleName, 3, "invalid address")
+	ErrInvalidIBCSender = sdkerrors.Register(ModuleName, 4, "invalid IBC sender")
+	ErrEmptyProposal    = sdkerrors.Register(ModuleName, 5, "proposal does not change the blocklist")
+)
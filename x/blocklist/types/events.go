@@ -0,0 +1,14 @@
+package types
+
+// Blocklist module event types.
+const (
+	EventTypeUpdateBlocklist   = "update_blocklist"
+	EventTypeRejectIBCTransfer = "reject_ibc_transfer"
+
+	AttributeKeyBlockedAddress   = "blocked_address"
+	AttributeKeyUnblockedAddress = "unblocked_address"
+	AttributeKeyBlockedSender    = "blocked_ibc_sender"
+	AttributeKeyUnblockedSender  = "unblocked_ibc_sender"
+	AttributeKeySender           = "sender"
+	AttributeKeyReceiver         = "receiver"
+)
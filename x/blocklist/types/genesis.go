@@ -0,0 +1,24 @@
+package types
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(blockedAddresses, blockedIBCSenders []string) *GenesisState {
+	return &GenesisState{
+		BlockedAddresses:  blockedAddresses,
+		BlockedIbcSenders: blockedIBCSenders,
+	}
+}
+
+// DefaultGenesis returns the default blocklist genesis state with nothing blocked.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]string{}, []string{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := validateAddresses(gs.BlockedAddresses); err != nil {
+		return err
+	}
+
+	return validateIBCSenders(gs.BlockedIbcSenders)
+}
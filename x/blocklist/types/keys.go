@@ -0,0 +1,33 @@
+package types
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "blocklist"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the blocklist proposals.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
+
+var (
+	// BlockedAddressPrefix is the store prefix of the blocked Nolus addresses.
+	BlockedAddressPrefix = []byte{0x01}
+
+	// BlockedIBCSenderPrefix is the store prefix of the blocked senders of incoming IBC transfers.
+	BlockedIBCSenderPrefix = []byte{0x02}
+)
+
+// BlockedAddressKey returns the store key of a blocked Nolus address.
+func BlockedAddressKey(addr []byte) []byte {
+	return append(append([]byte{}, BlockedAddressPrefix...), addr...)
+}
+
+// BlockedIBCSenderKey returns the store key of a blocked IBC sender.
+func BlockedIBCSenderKey(sender string) []byte {
+	return append(append([]byte{}, BlockedIBCSenderPrefix...), []byte(sender)...)
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateBlocklist defines the type for an UpdateBlocklistProposal.
+	ProposalTypeUpdateBlocklist = "UpdateBlocklist"
+)
+
+// Assert UpdateBlocklistProposal implements govtypes.Content at compile-time.
+var _ govtypes.Content = &UpdateBlocklistProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateBlocklist)
+	govtypes.RegisterProposalTypeCodec(&UpdateBlocklistProposal{}, "blocklist/UpdateBlocklistProposal")
+}
+
+// NewUpdateBlocklistProposal creates a new blocklist update proposal.
+func NewUpdateBlocklistProposal(title, description string, addAddresses, removeAddresses, addIBCSenders, removeIBCSenders []string) *UpdateBlocklistProposal {
+	return &UpdateBlocklistProposal{
+		Title:            title,
+		Description:      description,
+		AddAddresses:     addAddresses,
+		RemoveAddresses:  removeAddresses,
+		AddIbcSenders:    addIBCSenders,
+		RemoveIbcSenders: removeIBCSenders,
+	}
+}
+
+// GetTitle returns the title of a blocklist update proposal.
+func (p *UpdateBlocklistProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a blocklist update proposal.
+func (p *UpdateBlocklistProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a blocklist update proposal.
+func (p *UpdateBlocklistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a blocklist update proposal.
+func (p *UpdateBlocklistProposal) ProposalType() string { return ProposalTypeUpdateBlocklist }
+
+// ValidateBasic runs basic stateless validity checks.
+func (p *UpdateBlocklistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.AddAddresses)+len(p.RemoveAddresses)+len(p.AddIbcSenders)+len(p.RemoveIbcSenders) == 0 {
+		return ErrEmptyProposal
+	}
+
+	for _, addrs := range [][]string{p.AddAddresses, p.RemoveAddresses} {
+		if err := validateAddresses(addrs); err != nil {
+			return err
+		}
+	}
+
+	for _, senders := range [][]string{p.AddIbcSenders, p.RemoveIbcSenders} {
+		if err := validateIBCSenders(senders); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UpdateBlocklistProposal) String() string {
+	return fmt.Sprintf(`Update Blocklist Proposal:
+  Title:              %s
+  Description:        %s
+  Add Addresses:      %s
+  Remove Addresses:   %s
+  Add IBC Senders:    %s
+  Remove IBC Senders: %s
+`, p.Title, p.Description,
+		strings.Join(p.AddAddresses, ", "), strings.Join(p.RemoveAddresses, ", "),
+		strings.Join(p.AddIbcSenders, ", "), strings.Join(p.RemoveIbcSenders, ", "))
+}
+
+func validateAddresses(addrs []string) error {
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAddress, "%s: %s", addr, err)
+		}
+		if seen[addr] {
+			return sdkerrors.Wrapf(ErrInvalidAddress, "duplicate address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+func validateIBCSenders(senders []string) error {
+	seen := make(map[string]bool, len(senders))
+	for _, sender := range senders {
+		if strings.TrimSpace(sender) == "" {
+			return sdkerrors.Wrap(ErrInvalidIBCSender, "sender can not be blank")
+		}
+		if seen[sender] {
+			return sdkerrors.Wrapf(ErrInvalidIBCSender, "duplicate sender %s", sender)
+		}
+		seen[sender] = true
+	}
+
+	return nil
+}
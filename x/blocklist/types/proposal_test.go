@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/blocklist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestUpdateBlocklistProposal_ValidateBasic(t *testing.T) {
+	params.SetAddressPrefixes()
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	for _, tc := range []struct {
+		desc     string
+		proposal *types.UpdateBlocklistProposal
+		err      error
+	}{
+		{
+			desc:     "valid proposal",
+			proposal: types.NewUpdateBlocklistProposal("title", "description", []string{addr}, nil, []string{"osmo1sender"}, nil),
+		},
+		{
+			desc:     "empty proposal",
+			proposal: types.NewUpdateBlocklistProposal("title", "description", nil, nil, nil, nil),
+			err:      types.ErrEmptyProposal,
+		},
+		{
+			desc:     "ethereum address",
+			proposal: types.NewUpdateBlocklistProposal("title", "description", []string{"0xd882cfc20f52f2599d84b8e8d58c7fb62cfe344b"}, nil, nil, nil),
+			err:      types.ErrInvalidAddress,
+		},
+		{
+			desc:     "duplicate address",
+			proposal: types.NewUpdateBlocklistProposal("title", "description", nil, []string{addr, addr}, nil, nil),
+			err:      types.ErrInvalidAddress,
+		},
+		{
+			desc:     "blank IBC sender",
+			proposal: types.NewUpdateBlocklistProposal("title", "description", nil, nil, nil, []string{" "}),
+			err:      types.ErrInvalidIBCSender,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestGenesisState_Validate(t *testing.T) {
+	params.SetAddressPrefixes()
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	require.NoError(t, types.DefaultGenesis().Validate())
+	require.NoError(t, types.NewGenesisState([]string{addr}, []string{"osmo1sender"}).Validate())
+	require.Error(t, types.NewGenesisState([]string{"nolus1invalid"}, nil).Validate())
+	require.Error(t, types.NewGenesisState(nil, []string{"osmo1sender", "osmo1sender"}).Validate())
+}
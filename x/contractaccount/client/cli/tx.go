@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/types"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewCmdRegisterAuthenticator(),
+		NewCmdRemoveAuthenticator(),
+	)
+
+	return cmd
+}
+
+// NewCmdRegisterAuthenticator implements a command handler for turning the sender into a contract account.
+func NewCmdRegisterAuthenticator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-authenticator [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Authenticate the transactions of the sender with the given contract",
+		Long: "Authenticate the transactions of the sender with the given contract instead of its public key.\n" +
+			"The contract must accept the transactions of the account through its sudo entry point.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authenticator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterAuthenticator(clientCtx.GetFromAddress(), authenticator)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRemoveAuthenticator implements a command handler for turning the sender back into a public key account.
+func NewCmdRemoveAuthenticator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-authenticator",
+		Args:  cobra.NoArgs,
+		Short: "Authenticate the transactions of the sender with its public key again",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveAuthenticator(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterAuthenticator turns the account into a contract account authenticated by the given contract.
+// A contract account only changes its authenticator. The contract has to accept the account,
+// otherwise any contract could be set as an authenticator without supporting it. Like when authenticating,
+// the contract may not dispatch messages.
+func (k Keeper) RegisterAuthenticator(ctx sdk.Context, addr, authenticator sdk.AccAddress) error {
+	if !k.wasmKeeper.HasContractInfo(ctx, authenticator) {
+		return sdkerrors.Wrap(types.ErrInvalidAuthenticator, authenticator.String())
+	}
+
+	var newAcc authtypes.AccountI
+	switch acc := k.accountKeeper.GetAccount(ctx, addr).(type) {
+	case *authtypes.BaseAccount:
+		newAcc = types.NewContractAccount(acc, authenticator)
+	case *types.ContractAccount:
+		acc.Authenticator = authenticator.String()
+		newAcc = acc
+	case nil:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidAccount, "%T", acc)
+	}
+
+	var msg types.MessageRegisterAuthenticator
+	msg.RegisterAuthenticator.Account = addr.String()
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	if _, err := k.wasmKeeper.Sudo(authenticatorCall(ctx), authenticator, bz); err != nil {
+		return sdkerrors.Wrapf(types.ErrAuthenticatorNotAccepted, "%s: %s", authenticator, err)
+	}
+
+	k.accountKeeper.SetAccount(ctx, newAcc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAuthenticator, authenticator.String()),
+		),
+	)
+
+	return nil
+}
+
+// RemoveAuthenticator turns the contract account back into a base account authenticated by its public key.
+func (k Keeper) RemoveAuthenticator(ctx sdk.Context, addr sdk.AccAddress) error {
+	acc, ok := k.accountKeeper.GetAccount(ctx, addr).(*types.ContractAccount)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrNoAuthenticator, addr.String())
+	}
+
+	k.accountKeeper.SetAccount(ctx, acc.BaseAccount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAuthenticator, acc.Authenticator),
+		),
+	)
+
+	return nil
+}
+
+// Authenticate calls the authenticator contract of the account with the sign bytes and the signature
+// of a transaction. The transaction is rejected if the contract returns an error, dispatches messages
+// or runs out of the authenticator gas limit.
+func (k Keeper) Authenticate(ctx sdk.Context, acc *types.ContractAccount, signBytes, signature []byte) (err error) {
+	var msg types.MessageAuthenticate
+	msg.Authenticate.Account = acc.GetAddress().String()
+	msg.Authenticate.SignBytes = signBytes
+	msg.Authenticate.Signature = signature
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	gasMeter := sdk.NewGasMeter(types.AuthenticatorGasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(types.ErrUnauthenticated, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "authenticator")
+	}()
+
+	if _, err := k.wasmKeeper.Sudo(authenticatorCall(ctx).WithGasMeter(gasMeter), acc.GetAuthenticator(), bz); err != nil {
+		k.Logger(ctx).Debug("Authenticate: contract rejected the transaction", "account", msg.Authenticate.Account, "error", err)
+		return sdkerrors.Wrap(types.ErrUnauthenticated, err.Error())
+	}
+
+	return nil
+}
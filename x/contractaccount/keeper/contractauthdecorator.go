@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/custom/util"
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// ContractAuthDecorator authenticates the transactions signed by contract accounts with their authenticator contracts
+// instead of the wrapped public key signature decorators. The contract gets the sign bytes and the signature
+// of the account and rejects the transaction by returning an error.
+// Transactions mixing contract and public key signers are rejected.
+// Call the wrapped decorators unless all signers are contract accounts, then call next AnteHandler
+// CONTRACT: Tx must implement SigVerifiableTx interface to use ContractAuthDecorator.
+type ContractAuthDecorator struct {
+	k               Keeper
+	signModeHandler authsigning.SignModeHandler
+	sigDecorators   []sdk.AnteDecorator
+}
+
+func NewContractAuthDecorator(k Keeper, signModeHandler authsigning.SignModeHandler, sigDecorators ...sdk.AnteDecorator) ContractAuthDecorator {
+	return ContractAuthDecorator{
+		k:               k,
+		signModeHandler: signModeHandler,
+		sigDecorators:   sigDecorators,
+	}
+}
+
+func (cad ContractAuthDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers := sigTx.GetSigners()
+	accounts := make([]*types.ContractAccount, 0, len(signers))
+	for _, signer := range signers {
+		if acc, ok := cad.k.accountKeeper.GetAccount(ctx, signer).(*types.ContractAccount); ok {
+			accounts = append(accounts, acc)
+		}
+	}
+
+	if len(accounts) == 0 {
+		return util.ChainWrappedAnteDecorators(next, cad.sigDecorators...)(ctx, tx, simulate)
+	}
+
+	if len(accounts) != len(signers) {
+		return ctx, types.ErrMixedSigners
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(sigs) != len(accounts) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(accounts), len(sigs))
+	}
+
+	for i, sig := range sigs {
+		acc := accounts[i]
+
+		if sig.Sequence != acc.GetSequence() {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		// the authenticator contract is run in DeliverTx and CheckTx only, same as the public key signature verification
+		if simulate || ctx.IsReCheckTx() {
+			continue
+		}
+
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok {
+			return ctx, sdkerrors.Wrapf(types.ErrUnauthenticated, "contract account %s requires a single signature", acc.GetAddress())
+		}
+
+		accNum := acc.GetAccountNumber()
+		if ctx.BlockHeight() == 0 {
+			accNum = 0
+		}
+		signerData := authsigning.SignerData{
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      acc.GetSequence(),
+		}
+
+		signBytes, err := cad.signModeHandler.GetSignBytes(data.SignMode, signerData, tx)
+		if err != nil {
+			return ctx, err
+		}
+
+		if err := cad.k.Authenticate(ctx, acc, signBytes, data.Signature); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
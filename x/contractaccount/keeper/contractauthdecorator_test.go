@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/types"
+)
+
+// countingDecorator stands for the public key signature decorators.
+type countingDecorator struct {
+	calls *int
+}
+
+func (cd countingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*cd.calls++
+	return next(ctx, tx, simulate)
+}
+
+func TestContractAuthDecorator(t *testing.T) {
+	contract, dispatchingContract := randomAddress(), randomAddress()
+	app, ctx, k, wk := setupKeeper(t, contract, dispatchingContract)
+	ctx = ctx.WithBlockHeight(1)
+
+	newAccount := func() sdk.AccAddress {
+		addr := randomAddress()
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+		return addr
+	}
+	contractAcc, otherContractAcc, pubKeyAcc := newAccount(), newAccount(), newAccount()
+	dispatchingContractAcc := newAccount()
+	require.NoError(t, k.RegisterAuthenticator(ctx, contractAcc, contract))
+	require.NoError(t, k.RegisterAuthenticator(ctx, otherContractAcc, contract))
+	require.NoError(t, k.RegisterAuthenticator(ctx, dispatchingContractAcc, dispatchingContract))
+	wk.allowed[contractAcc.String()] = true
+	wk.allowed[dispatchingContractAcc.String()] = true
+	wk.dispatching[dispatchingContract.String()] = true
+
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	signModeHandler := encodingConfig.TxConfig.SignModeHandler()
+
+	newTx := func(sequence uint64, signers ...sdk.AccAddress) authsigning.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		var msgs []sdk.Msg
+		var sigs []signing.SignatureV2
+		for _, signer := range signers {
+			msgs = append(msgs, banktypes.NewMsgSend(signer, contract, sdk.NewCoins(sdk.NewInt64Coin("unls", 1))))
+			// the public key is not checked, the authenticator contract defines the credentials of the account
+			sigs = append(sigs, signing.SignatureV2{
+				PubKey: secp256k1.GenPrivKey().PubKey(),
+				Data: &signing.SingleSignatureData{
+					SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+					Signature: []byte("signature"),
+				},
+				Sequence: sequence,
+			})
+		}
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		require.NoError(t, txBuilder.SetSignatures(sigs...))
+		return txBuilder.GetTx()
+	}
+
+	testCases := []struct {
+		title       string
+		tx          authsigning.Tx
+		simulate    bool
+		expErr      error
+		expSigCalls int
+		expAuth     bool
+	}{
+		{
+			title:       "public key account runs the signature decorators",
+			tx:          newTx(0, pubKeyAcc),
+			expSigCalls: 1,
+		},
+		{
+			title:   "contract account accepted by the contract",
+			tx:      newTx(0, contractAcc),
+			expAuth: true,
+		},
+		{
+			title:   "contract account rejected by the contract",
+			tx:      newTx(0, otherContractAcc),
+			expErr:  types.ErrUnauthenticated,
+			expAuth: true,
+		},
+		{
+			title:   "contract account accepted by a contract sending a bank message",
+			tx:      newTx(0, dispatchingContractAcc),
+			expErr:  types.ErrUnauthenticated,
+			expAuth: true,
+		},
+		{
+			title:  "contract account with a wrong sequence",
+			tx:     newTx(1, contractAcc),
+			expErr: sdkerrors.ErrWrongSequence,
+		},
+		{
+			title:    "simulation skips the contract",
+			tx:       newTx(0, otherContractAcc),
+			simulate: true,
+		},
+		{
+			title:  "contract and public key accounts mixed",
+			tx:     newTx(0, contractAcc, pubKeyAcc),
+			expErr: types.ErrMixedSigners,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			wk.calls = nil
+			sigCalls := 0
+
+			anteHandler := sdk.ChainAnteDecorators(keeper.NewContractAuthDecorator(k, signModeHandler, countingDecorator{calls: &sigCalls}))
+			_, err := anteHandler(ctx, tc.tx, tc.simulate)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expSigCalls, sigCalls)
+			require.Empty(t, *wk.dispatched)
+
+			if !tc.expAuth {
+				require.Empty(t, wk.calls)
+				return
+			}
+
+			signer := tc.tx.GetSigners()[0]
+			acc := app.AccountKeeper.GetAccount(ctx, signer)
+			signBytes, err := signModeHandler.GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+				ChainID:       ctx.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      acc.GetSequence(),
+			}, tc.tx)
+			require.NoError(t, err)
+
+			require.Len(t, wk.calls, 1)
+			require.Equal(t, signer.String(), wk.calls[0].Authenticate.Account)
+			require.Equal(t, signBytes, wk.calls[0].Authenticate.SignBytes)
+			require.Equal(t, []byte("signature"), wk.calls[0].Authenticate.Signature)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	Keeper struct {
+		cdc           codec.BinaryCodec
+		accountKeeper types.AccountKeeper
+		wasmKeeper    types.WasmKeeper
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	accountKeeper types.AccountKeeper,
+	wasmKeeper types.WasmKeeper,
+) *Keeper {
+	return &Keeper{
+		cdc:           cdc,
+		accountKeeper: accountKeeper,
+		wasmKeeper:    wasmKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/types"
+)
+
+// mockWasmKeeper accepts the transactions of the allowed accounts through any known contract
+// that does not refuse to be an authenticator. The dispatching contracts send a bank message along their response
+// through the messenger of the keeper.
+type mockWasmKeeper struct {
+	contracts   map[string]bool
+	refusing    map[string]bool
+	dispatching map[string]bool
+	allowed     map[string]bool
+	gas         uint64
+	calls       []types.MessageAuthenticate
+	messenger   wasmkeeper.Messenger
+	dispatched  *[]wasmvmtypes.CosmosMsg
+}
+
+func newMockWasmKeeper(contracts ...sdk.AccAddress) *mockWasmKeeper {
+	dispatched := &[]wasmvmtypes.CosmosMsg{}
+	wk := &mockWasmKeeper{
+		contracts:   map[string]bool{},
+		refusing:    map[string]bool{},
+		dispatching: map[string]bool{},
+		allowed:     map[string]bool{},
+		messenger:   keeper.NewMessengerDecorator()(recordingMessenger{dispatched: dispatched}),
+		dispatched:  dispatched,
+	}
+	for _, contract := range contracts {
+		wk.contracts[contract.String()] = true
+	}
+
+	return wk
+}
+
+// recordingMessenger records the messages dispatched by the contracts.
+type recordingMessenger struct {
+	dispatched *[]wasmvmtypes.CosmosMsg
+}
+
+func (m recordingMessenger) DispatchMsg(_ sdk.Context, _ sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	*m.dispatched = append(*m.dispatched, msg)
+	return nil, nil, nil
+}
+
+// dispatch sends the bank message of a dispatching contract, as the wasm keeper does with the messages of the response.
+func (wk *mockWasmKeeper) dispatch(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	if !wk.dispatching[contractAddress.String()] {
+		return nil
+	}
+
+	msg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+		ToAddress: randomAddress().String(),
+		Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(1000, "unls")},
+	}}}
+	_, _, err := wk.messenger.DispatchMsg(ctx, contractAddress, "", msg)
+	return err
+}
+
+func (wk *mockWasmKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return wk.contracts[contractAddress.String()]
+}
+
+func (wk *mockWasmKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	if !wk.contracts[contractAddress.String()] {
+		return nil, errors.New("no such contract")
+	}
+
+	var register types.MessageRegisterAuthenticator
+	if err := json.Unmarshal(msg, &register); err == nil && register.RegisterAuthenticator.Account != "" {
+		if wk.refusing[contractAddress.String()] {
+			return nil, errors.New("unknown variant `register_authenticator`")
+		}
+		return nil, wk.dispatch(ctx, contractAddress)
+	}
+
+	var auth types.MessageAuthenticate
+	if err := json.Unmarshal(msg, &auth); err != nil {
+		return nil, err
+	}
+	ctx.GasMeter().ConsumeGas(wk.gas, "authenticate")
+	wk.calls = append(wk.calls, auth)
+
+	if !wk.allowed[auth.Authenticate.Account] {
+		return nil, errors.New("unauthorized")
+	}
+
+	return nil, wk.dispatch(ctx, contractAddress)
+}
+
+func randomAddress() sdk.AccAddress {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func setupKeeper(t *testing.T, contracts ...sdk.AccAddress) (*nolusapp.App, sdk.Context, keeper.Keeper, *mockWasmKeeper) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	wk := newMockWasmKeeper(contracts...)
+
+	return app, ctx, *keeper.NewKeeper(app.AppCodec(), app.AccountKeeper, wk), wk
+}
+
+func TestRegisterAuthenticator(t *testing.T) {
+	contract, otherContract, refusingContract := randomAddress(), randomAddress(), randomAddress()
+	app, ctx, k, wk := setupKeeper(t, contract, otherContract, refusingContract)
+	wk.refusing[refusingContract.String()] = true
+
+	addr := randomAddress()
+	require.ErrorIs(t, k.RegisterAuthenticator(ctx, addr, contract), sdkerrors.ErrUnknownAddress)
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	require.ErrorIs(t, k.RegisterAuthenticator(ctx, addr, randomAddress()), types.ErrInvalidAuthenticator)
+	require.ErrorIs(t, k.RegisterAuthenticator(ctx, addr, refusingContract), types.ErrAuthenticatorNotAccepted)
+	_, ok := app.AccountKeeper.GetAccount(ctx, addr).(*types.ContractAccount)
+	require.False(t, ok)
+
+	require.NoError(t, k.RegisterAuthenticator(ctx, addr, contract))
+	acc, ok := app.AccountKeeper.GetAccount(ctx, addr).(*types.ContractAccount)
+	require.True(t, ok)
+	require.Equal(t, contract, acc.GetAuthenticator())
+
+	require.NoError(t, k.RegisterAuthenticator(ctx, addr, otherContract))
+	acc, ok = app.AccountKeeper.GetAccount(ctx, addr).(*types.ContractAccount)
+	require.True(t, ok)
+	require.Equal(t, otherContract, acc.GetAuthenticator())
+
+	require.ErrorIs(t, k.RegisterAuthenticator(ctx, addr, refusingContract), types.ErrAuthenticatorNotAccepted)
+	acc, ok = app.AccountKeeper.GetAccount(ctx, addr).(*types.ContractAccount)
+	require.True(t, ok)
+	require.Equal(t, otherContract, acc.GetAuthenticator())
+}
+
+func TestAuthenticatorMessagesRejected(t *testing.T) {
+	contract := randomAddress()
+	app, ctx, k, wk := setupKeeper(t, contract)
+
+	addr := randomAddress()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+
+	// an authenticator accepting the account along a message is refused
+	wk.dispatching[contract.String()] = true
+	require.ErrorIs(t, k.RegisterAuthenticator(ctx, addr, contract), types.ErrAuthenticatorNotAccepted)
+	_, ok := app.AccountKeeper.GetAccount(ctx, addr).(*types.ContractAccount)
+	require.False(t, ok)
+
+	wk.dispatching[contract.String()] = false
+	require.NoError(t, k.RegisterAuthenticator(ctx, addr, contract))
+	acc, ok := app.AccountKeeper.GetAccount(ctx, addr).(*types.ContractAccount)
+	require.True(t, ok)
+
+	// a transaction accepted by the authenticator along a message is rejected
+	wk.allowed[addr.String()] = true
+	wk.dispatching[contract.String()] = true
+	err := k.Authenticate(ctx, acc, []byte("sign bytes"), []byte("signature"))
+	require.ErrorIs(t, err, types.ErrUnauthenticated)
+	require.Contains(t, err.Error(), types.ErrMessagesNotAllowed.Error())
+	require.Empty(t, *wk.dispatched)
+
+	// the contracts dispatch their messages outside of the authentication
+	require.NoError(t, wk.dispatch(ctx, contract))
+	require.Len(t, *wk.dispatched, 1)
+}
+
+func TestAuthenticateGasLimit(t *testing.T) {
+	contract := randomAddress()
+	app, ctx, k, wk := setupKeeper(t, contract)
+
+	addr := randomAddress()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	require.NoError(t, k.RegisterAuthenticator(ctx, addr, contract))
+	wk.allowed[addr.String()] = true
+	acc, ok := app.AccountKeeper.GetAccount(ctx, addr).(*types.ContractAccount)
+	require.True(t, ok)
+
+	wk.gas = types.AuthenticatorGasLimit
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.NoError(t, k.Authenticate(ctx, acc, []byte("sign bytes"), []byte("signature")))
+	require.Equal(t, types.AuthenticatorGasLimit, ctx.GasMeter().GasConsumed())
+
+	wk.gas = types.AuthenticatorGasLimit + 1
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.ErrorIs(t, k.Authenticate(ctx, acc, []byte("sign bytes"), []byte("signature")), types.ErrUnauthenticated)
+	require.Equal(t, types.AuthenticatorGasLimit, ctx.GasMeter().GasConsumed())
+}
+
+func TestRemoveAuthenticator(t *testing.T) {
+	contract := randomAddress()
+	app, ctx, k, _ := setupKeeper(t, contract)
+
+	addr := randomAddress()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	require.ErrorIs(t, k.RemoveAuthenticator(ctx, addr), types.ErrNoAuthenticator)
+
+	require.NoError(t, k.RegisterAuthenticator(ctx, addr, contract))
+	require.NoError(t, k.RemoveAuthenticator(ctx, addr))
+
+	acc := app.AccountKeeper.GetAccount(ctx, addr)
+	_, ok := acc.(*types.ContractAccount)
+	require.False(t, ok)
+	require.Equal(t, addr, acc.GetAddress())
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/types"
+)
+
+// authenticatorCallKey is the context key of the calls to the authenticator contracts.
+type authenticatorCallKey struct{}
+
+// authenticatorCall returns the context the authenticator contract is called in.
+func authenticatorCall(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(authenticatorCallKey{}, true)
+}
+
+// NewMessengerDecorator returns the wasm messenger decorator rejecting the messages dispatched by the authenticator
+// contracts, failing their call. The contracts authenticate the transactions in the ante handler, whose changes are
+// kept even when the messages of the transaction fail, so their messages would run unpaid along every transaction.
+func NewMessengerDecorator() func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(messenger wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return authenticatorMessenger{wrapped: messenger}
+	}
+}
+
+type authenticatorMessenger struct {
+	wrapped wasmkeeper.Messenger
+}
+
+func (m authenticatorMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if isAuthenticatorCall, _ := ctx.Value(authenticatorCallKey{}).(bool); isAuthenticatorCall {
+		return nil, nil, sdkerrors.Wrap(types.ErrMessagesNotAllowed, contractAddr.String())
+	}
+
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
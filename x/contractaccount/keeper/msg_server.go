@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractaccount/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) RegisterAuthenticator(goCtx context.Context, msg *types.MsgRegisterAuthenticator) (*types.MsgRegisterAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	authenticator, err := sdk.AccAddressFromBech32(msg.Authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RegisterAuthenticator(ctx, sender, authenticator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterAuthenticatorResponse{}, nil
+}
+
+func (k msgServer) RemoveAuthenticator(goCtx context.Context, msg *types.MsgRemoveAuthenticator) (*types.MsgRemoveAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveAuthenticator(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
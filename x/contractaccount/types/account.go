@@ -0,0 +1,75 @@
+package types
+
+import (
+	"errors"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ authtypes.AccountI       = (*ContractAccount)(nil)
+	_ authtypes.GenesisAccount = (*ContractAccount)(nil)
+)
+
+// NewContractAccount turns a base account into an account authenticated by the given contract.
+func NewContractAccount(baseAccount *authtypes.BaseAccount, authenticator sdk.AccAddress) *ContractAccount {
+	return &ContractAccount{
+		BaseAccount:   baseAccount,
+		Authenticator: authenticator.String(),
+	}
+}
+
+// GetAuthenticator returns the address of the contract authenticating the account.
+func (acc ContractAccount) GetAuthenticator() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(acc.Authenticator)
+	return addr
+}
+
+// Validate checks for errors on the account fields.
+func (acc ContractAccount) Validate() error {
+	if acc.BaseAccount == nil {
+		return errors.New("contract account has no base account")
+	}
+	if _, err := sdk.AccAddressFromBech32(acc.Authenticator); err != nil {
+		return ErrInvalidAuthenticator.Wrap(err.Error())
+	}
+
+	return acc.BaseAccount.Validate()
+}
+
+type contractAccountPretty struct {
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	PubKey        string         `json:"public_key" yaml:"public_key"`
+	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
+	Sequence      uint64         `json:"sequence" yaml:"sequence"`
+	Authenticator string         `json:"authenticator" yaml:"authenticator"`
+}
+
+func (acc ContractAccount) String() string {
+	out, _ := acc.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ContractAccount.
+func (acc ContractAccount) MarshalYAML() (interface{}, error) {
+	alias := contractAccountPretty{
+		Address:       acc.GetAddress(),
+		AccountNumber: acc.AccountNumber,
+		Sequence:      acc.Sequence,
+		Authenticator: acc.Authenticator,
+	}
+
+	if pk := acc.GetPubKey(); pk != nil {
+		alias.PubKey = pk.String()
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), nil
+}
@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/contractaccount module sentinel errors.
+var (
+	ErrInvalidAuthenticator     = sdkerrors.Register(ModuleName, 1, "authenticator is not a contract")
+	ErrInvalidAccount           = sdkerrors.Register(ModuleName, 2, "account type does not support an authenticator")
+	ErrNoAuthenticator          = sdkerrors.Register(ModuleName, 3, "account has no authenticator")
+	ErrMixedSigners             = sdkerrors.Register(ModuleName, 4, "contract accounts can not sign together with key authenticated accounts")
+	ErrUnauthenticated          = sdkerrors.Register(ModuleName, 5, "transaction rejected by the authenticator")
+	ErrAuthenticatorNotAccepted = sdkerrors.Register(ModuleName, 6, "account rejected by the authenticator")
+	ErrMessagesNotAllowed       = sdkerrors.Register(ModuleName, 7, "authenticator contracts can not dispatch messages")
+)
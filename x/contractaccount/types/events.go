@@ -0,0 +1,10 @@
+package types
+
+// Contract account module event types.
+const (
+	EventTypeRegisterAuthenticator = "register_authenticator"
+	EventTypeRemoveAuthenticator   = "remove_authenticator"
+
+	AttributeKeyAccount       = "account"
+	AttributeKeyAuthenticator = "authenticator"
+)
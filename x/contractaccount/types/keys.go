@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "contractaccount"
+
+	// RouterKey is the message route for the contract account messages.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,82 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+const (
+	TypeMsgRegisterAuthenticator = "register_authenticator"
+	TypeMsgRemoveAuthenticator   = "remove_authenticator"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgRegisterAuthenticator{}
+	_ legacytx.LegacyMsg = &MsgRemoveAuthenticator{}
+)
+
+// NewMsgRegisterAuthenticator creates a new MsgRegisterAuthenticator instance.
+func NewMsgRegisterAuthenticator(sender, authenticator sdk.AccAddress) *MsgRegisterAuthenticator {
+	return &MsgRegisterAuthenticator{Sender: sender.String(), Authenticator: authenticator.String()}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgRegisterAuthenticator) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgRegisterAuthenticator) Type() string { return TypeMsgRegisterAuthenticator }
+
+// ValidateBasic runs stateless checks on the message.
+func (msg MsgRegisterAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authenticator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authenticator address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRegisterAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterAuthenticator.
+func (msg MsgRegisterAuthenticator) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRemoveAuthenticator creates a new MsgRemoveAuthenticator instance.
+func NewMsgRemoveAuthenticator(sender sdk.AccAddress) *MsgRemoveAuthenticator {
+	return &MsgRemoveAuthenticator{Sender: sender.String()}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgRemoveAuthenticator) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgRemoveAuthenticator) Type() string { return TypeMsgRemoveAuthenticator }
+
+// ValidateBasic runs stateless checks on the message.
+func (msg MsgRemoveAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveAuthenticator.
+func (msg MsgRemoveAuthenticator) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,28 @@
+package types
+
+// AuthenticatorGasLimit is the gas available to the authenticator contract for a single transaction.
+// The limit keeps the cost of the ante handler bounded regardless of the gas limit of the transaction.
+const AuthenticatorGasLimit uint64 = 200_000
+
+// MessageRegisterAuthenticator is passed to the sudo() entrypoint of the contract when an account
+// registers it as its authenticator. The contract opts in by returning no error and no messages.
+type MessageRegisterAuthenticator struct {
+	RegisterAuthenticator struct {
+		// Account is the bech32 address of the account registering the contract.
+		Account string `json:"account"`
+	} `json:"register_authenticator"`
+}
+
+// MessageAuthenticate is passed to the sudo() entrypoint of the authenticator contract
+// for every transaction signed by a contract account. The contract rejects the transaction
+// by returning an error. A response with messages rejects the transaction as well.
+type MessageAuthenticate struct {
+	Authenticate struct {
+		// Account is the bech32 address of the contract account signing the transaction.
+		Account string `json:"account"`
+		// SignBytes are the bytes signed by the account according to the sign mode of the signature.
+		SignBytes []byte `json:"sign_bytes"`
+		// Signature is the signature of the account as present in the transaction.
+		Signature []byte `json:"signature"`
+	} `json:"authenticate"`
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+)
+
+// GetTxCmd returns the transaction commands for this module. They are grouped under the name of the
+// contract manager, which records the failures.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "contractmanager",
+		Short:                      "contract manager transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewCmdResubmit(),
+	)
+
+	return cmd
+}
+
+// NewCmdResubmit implements a command handler for calling back a contract again with a failed callback.
+func NewCmdResubmit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resubmit [contract-address] [failure-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Call back a contract again with one of its failed callbacks",
+		Long: "Call back a contract again with the sudo message of one of its failed callbacks.\n" +
+			"The sender must be the contract admin. The failure is removed once the callback succeeds.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			failureID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid failure id: %w", err)
+			}
+
+			msg := types.NewMsgResubmitFailure(clientCtx.GetFromAddress(), contract, failureID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
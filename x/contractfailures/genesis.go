@@ -0,0 +1,21 @@
+package contractfailures
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the contractfailures module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, failedSudo := range genState.FailedSudos {
+		k.SetFailedSudo(ctx, failedSudo)
+	}
+}
+
+// ExportGenesis returns the contractfailures module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllFailedSudos(ctx))
+}
@@ -0,0 +1,86 @@
+package contractfailures_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures"
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+)
+
+func TestGenesis(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	genesisState := *types.NewGenesisState(types.NewParams(2_000_000), []types.FailedSudo{
+		{Address: contract, FailureId: 0, Msg: []byte(`{"timeout":{}}`)},
+		{Address: contract, FailureId: 3, Msg: []byte(`{"error":{}}`)},
+	})
+	require.NoError(t, genesisState.Validate())
+
+	contractfailures.InitGenesis(ctx, app.ContractFailuresKeeper, genesisState)
+	got := contractfailures.ExportGenesis(ctx, app.ContractFailuresKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	failedSudo := types.FailedSudo{Address: contract, FailureId: 1, Msg: []byte(`{"timeout":{}}`)}
+
+	testCases := []struct {
+		title       string
+		params      types.Params
+		failedSudos func() []types.FailedSudo
+		valid       bool
+	}{
+		{
+			title:       "default genesis",
+			params:      types.DefaultParams(),
+			failedSudos: func() []types.FailedSudo { return nil },
+			valid:       true,
+		},
+		{
+			title:       "zero resubmit gas limit",
+			params:      types.NewParams(0),
+			failedSudos: func() []types.FailedSudo { return nil },
+		},
+		{
+			title:       "duplicate failure",
+			params:      types.DefaultParams(),
+			failedSudos: func() []types.FailedSudo { return []types.FailedSudo{failedSudo, failedSudo} },
+		},
+		{
+			title:  "invalid contract",
+			params: types.DefaultParams(),
+			failedSudos: func() []types.FailedSudo {
+				invalid := failedSudo
+				invalid.Address = "contract"
+				return []types.FailedSudo{invalid}
+			},
+		},
+		{
+			title:  "empty sudo message",
+			params: types.DefaultParams(),
+			failedSudos: func() []types.FailedSudo {
+				invalid := failedSudo
+				invalid.Msg = nil
+				return []types.FailedSudo{invalid}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := types.NewGenesisState(tc.params, tc.failedSudos()).Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+
+	contractmanagertypes "github.com/neutron-org/neutron/x/contractmanager/types"
+)
+
+// The keeper wraps the contract manager for the interchain transactions and transfer modules, which call back
+// the contracts through it and record the failed callbacks. The contract manager keeps only the packet of a
+// failure, so the keeper keeps the sudo message of every callback until it succeeds, or stores it along the
+// failure once recorded.
+var _ types.ContractManagerKeeper = Keeper{}
+
+// pendingSudosKey is the context key of the sudo messages of the callbacks being made.
+type pendingSudosKey struct{}
+
+// WithPendingSudos returns the context an acknowledgement or a timeout is handled in, keeping the sudo messages
+// of the callbacks made while handling it, by contract, channel and sequence. The callbacks are made in a cached
+// context discarded on failure, so the messages are passed along the call path rather than through a store.
+func WithPendingSudos(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(pendingSudosKey{}, map[string][]byte{})
+}
+
+// pendingSudos returns the sudo messages kept in the context, nil if none are.
+func pendingSudos(ctx sdk.Context) map[string][]byte {
+	pending, _ := ctx.Value(pendingSudosKey{}).(map[string][]byte)
+	return pending
+}
+
+// HasContractInfo implements the contract manager keeper.
+func (k Keeper) HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	return k.contractManagerKeeper.HasContractInfo(ctx, contractAddress)
+}
+
+// GetNextFailureIDKey implements the contract manager keeper.
+func (k Keeper) GetNextFailureIDKey(ctx sdk.Context, address string) uint64 {
+	return k.contractManagerKeeper.GetNextFailureIDKey(ctx, address)
+}
+
+// AddContractFailure records the failure with the contract manager, and stores the sudo message of the
+// failed callback kept in the context under the id of the failure.
+func (k Keeper) AddContractFailure(ctx sdk.Context, channelID string, address string, ackID uint64, ackType string) {
+	failureID := k.contractManagerKeeper.GetNextFailureIDKey(ctx, address)
+	k.contractManagerKeeper.AddContractFailure(ctx, channelID, address, ackID, ackType)
+
+	pending := pendingSudos(ctx)
+	key := pendingSudoKey(address, channelID, ackID)
+	if msg, found := pending[key]; found {
+		delete(pending, key)
+		k.SetFailedSudo(ctx, types.FailedSudo{Address: address, FailureId: failureID, Msg: msg})
+	}
+}
+
+// RemoveContractFailure implements the contract manager keeper.
+func (k Keeper) RemoveContractFailure(ctx sdk.Context, address string, failureID uint64) {
+	k.contractManagerKeeper.RemoveContractFailure(ctx, address, failureID)
+}
+
+// SudoResponse implements the contract manager keeper.
+func (k Keeper) SudoResponse(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, msg []byte) ([]byte, error) {
+	var sudo contractmanagertypes.MessageResponse
+	sudo.Response.Request = request
+	sudo.Response.Data = msg
+
+	return k.trackSudo(ctx, senderAddress, request, sudo, func() ([]byte, error) {
+		return k.contractManagerKeeper.SudoResponse(ctx, senderAddress, request, msg)
+	})
+}
+
+// SudoError implements the contract manager keeper.
+func (k Keeper) SudoError(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, details string) ([]byte, error) {
+	var sudo contractmanagertypes.MessageError
+	sudo.Error.Request = request
+	sudo.Error.Details = details
+
+	return k.trackSudo(ctx, senderAddress, request, sudo, func() ([]byte, error) {
+		return k.contractManagerKeeper.SudoError(ctx, senderAddress, request, details)
+	})
+}
+
+// SudoTimeout implements the contract manager keeper.
+func (k Keeper) SudoTimeout(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet) ([]byte, error) {
+	var sudo contractmanagertypes.MessageTimeout
+	sudo.Timeout.Request = request
+
+	return k.trackSudo(ctx, senderAddress, request, sudo, func() ([]byte, error) {
+		return k.contractManagerKeeper.SudoTimeout(ctx, senderAddress, request)
+	})
+}
+
+// Sudo calls back the contract with the sudo message of a packet callback built by a module wrapping the keeper,
+// keeping it like the messages of the contract manager callbacks.
+func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, request channeltypes.Packet, msg []byte) ([]byte, error) {
+	return k.trackSudo(ctx, contractAddress, request, json.RawMessage(msg), func() ([]byte, error) {
+		return k.wasmKeeper.Sudo(ctx, contractAddress, msg)
+	})
+}
+
+// SudoOnChanOpenAck implements the contract manager keeper. A failure of the callback fails the handshake,
+// so it is not recorded.
+func (k Keeper) SudoOnChanOpenAck(ctx sdk.Context, contractAddress sdk.AccAddress, details contractmanagertypes.OpenAckDetails) ([]byte, error) {
+	return k.contractManagerKeeper.SudoOnChanOpenAck(ctx, contractAddress, details)
+}
+
+// trackSudo keeps in the context the sudo message of the callback made by sudoFn while it is made. The message
+// is kept on failure, including running out of gas, until the failure is recorded.
+func (k Keeper) trackSudo(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, sudo interface{}, sudoFn func() ([]byte, error)) ([]byte, error) {
+	pending := pendingSudos(ctx)
+	if pending == nil {
+		return sudoFn()
+	}
+
+	msg, err := json.Marshal(sudo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sudo message: %v", err)
+	}
+
+	key := pendingSudoKey(senderAddress.String(), request.SourceChannel, request.Sequence)
+	pending[key] = msg
+	resp, err := sudoFn()
+	if err == nil {
+		delete(pending, key)
+	}
+
+	return resp, err
+}
+
+func pendingSudoKey(address, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%d", address, channelID, sequence)
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+type (
+	Keeper struct {
+		cdc        codec.BinaryCodec
+		storeKey   sdk.StoreKey
+		paramstore paramtypes.Subspace
+
+		contractManagerKeeper types.ContractManagerKeeper
+		wasmKeeper            types.WasmKeeper
+	}
+)
+
+// NewKeeper creates the contractfailures keeper. The wasm keeper is usually created later,
+// so a reference to it is expected.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	ps paramtypes.Subspace,
+	cmk types.ContractManagerKeeper,
+	wk types.WasmKeeper,
+) *Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		cdc:                   cdc,
+		storeKey:              storeKey,
+		paramstore:            ps,
+		contractManagerKeeper: cmk,
+		wasmKeeper:            wk,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams get all parameters as types.Params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams set the params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
+
+// SetFailedSudo stores the sudo message of a failed callback of a contract.
+func (k Keeper) SetFailedSudo(ctx sdk.Context, failedSudo types.FailedSudo) {
+	contract := sdk.MustAccAddressFromBech32(failedSudo.Address)
+	ctx.KVStore(k.storeKey).Set(types.FailedSudoKey(contract, failedSudo.FailureId), k.cdc.MustMarshal(&failedSudo))
+}
+
+// GetFailedSudo returns the sudo message of a failed callback of a contract.
+func (k Keeper) GetFailedSudo(ctx sdk.Context, contract sdk.AccAddress, failureID uint64) (types.FailedSudo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FailedSudoKey(contract, failureID))
+	if bz == nil {
+		return types.FailedSudo{}, false
+	}
+
+	var failedSudo types.FailedSudo
+	k.cdc.MustUnmarshal(bz, &failedSudo)
+	return failedSudo, true
+}
+
+// DeleteFailedSudo removes the sudo message of a failed callback of a contract.
+func (k Keeper) DeleteFailedSudo(ctx sdk.Context, contract sdk.AccAddress, failureID uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.FailedSudoKey(contract, failureID))
+}
+
+// GetAllFailedSudos returns the sudo messages of all failed callbacks.
+func (k Keeper) GetAllFailedSudos(ctx sdk.Context) []types.FailedSudo {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FailedSudoPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	failedSudos := []types.FailedSudo{}
+	for ; iterator.Valid(); iterator.Next() {
+		var failedSudo types.FailedSudo
+		k.cdc.MustUnmarshal(iterator.Value(), &failedSudo)
+		failedSudos = append(failedSudos, failedSudo)
+	}
+
+	return failedSudos
+}
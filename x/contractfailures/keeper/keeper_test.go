@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+)
+
+// contractRecorder records the sudo messages of the contracts and emits an event before answering them.
+type contractRecorder struct {
+	admins map[string]string
+	msgs   [][]byte
+	gas    uint64
+	err    error
+}
+
+func (r *contractRecorder) HasContractInfo(_ sdk.Context, contract sdk.AccAddress) bool {
+	_, found := r.admins[contract.String()]
+	return found
+}
+
+func (r *contractRecorder) GetContractInfo(_ sdk.Context, contract sdk.AccAddress) *wasmtypes.ContractInfo {
+	admin, found := r.admins[contract.String()]
+	if !found {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{Admin: admin}
+}
+
+func (r *contractRecorder) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	r.msgs = append(r.msgs, msg)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("sudo"))
+	ctx.GasMeter().ConsumeGas(r.gas, "sudo")
+
+	return nil, r.err
+}
+
+func newAddress() sdk.AccAddress {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func TestRecordFailedSudos(t *testing.T) {
+	params.SetAddressPrefixes()
+	contract := newAddress()
+	wasmKeeper := &contractRecorder{admins: map[string]string{contract.String(): ""}}
+	k, contractManagerKeeper, ctx := keepertest.ContractFailuresKeeper(t, wasmKeeper)
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: "icacontroller-" + contract.String(), SourceChannel: "channel-0"}
+	ctx = keeper.WithPendingSudos(ctx)
+	// the callbacks are made in a cached context, which is discarded when they fail
+	cacheCtx, _ := ctx.CacheContext()
+
+	// the succeeding callbacks are not kept
+	_, err := k.SudoResponse(cacheCtx, contract, packet, []byte("data"))
+	require.NoError(t, err)
+	k.AddContractFailure(ctx, packet.SourceChannel, contract.String(), packet.Sequence, "ack")
+	require.Empty(t, k.GetAllFailedSudos(ctx))
+	require.Len(t, contractManagerKeeper.GetAllFailures(ctx), 1)
+
+	wasmKeeper.err = errors.New("contract failed")
+	packet.Sequence = 2
+	_, err = k.SudoError(cacheCtx, contract, packet, "rejected")
+	require.Error(t, err)
+	k.AddContractFailure(ctx, packet.SourceChannel, contract.String(), packet.Sequence, "ack")
+	packet.Sequence = 3
+	_, err = k.SudoTimeout(cacheCtx, contract, packet)
+	require.Error(t, err)
+	k.AddContractFailure(ctx, packet.SourceChannel, contract.String(), packet.Sequence, "timeout")
+	// the messages built by the wrapping modules are kept the same
+	packet.Sequence = 4
+	_, err = k.Sudo(cacheCtx, contract, packet, []byte(`{"timeout":{"callback_id":"refund"}}`))
+	require.Error(t, err)
+	k.AddContractFailure(ctx, packet.SourceChannel, contract.String(), packet.Sequence, "timeout")
+	require.Len(t, contractManagerKeeper.GetAllFailures(ctx), 4)
+	require.Equal(t, []types.FailedSudo{
+		{Address: contract.String(), FailureId: 1, Msg: wasmKeeper.msgs[1]},
+		{Address: contract.String(), FailureId: 2, Msg: wasmKeeper.msgs[2]},
+		{Address: contract.String(), FailureId: 3, Msg: wasmKeeper.msgs[3]},
+	}, k.GetAllFailedSudos(ctx))
+	require.Contains(t, string(wasmKeeper.msgs[1]), `"details":"rejected"`)
+	require.Contains(t, string(wasmKeeper.msgs[2]), `"timeout"`)
+	require.Equal(t, `{"timeout":{"callback_id":"refund"}}`, string(wasmKeeper.msgs[3]))
+
+	// the callbacks made outside the handling of an acknowledgement or a timeout keep no message
+	ctx = ctx.WithContext(context.Background())
+	packet.Sequence = 5
+	_, err = k.SudoTimeout(ctx, contract, packet)
+	require.Error(t, err)
+	k.AddContractFailure(ctx, packet.SourceChannel, contract.String(), packet.Sequence, "timeout")
+	require.Len(t, contractManagerKeeper.GetAllFailures(ctx), 5)
+	require.Len(t, k.GetAllFailedSudos(ctx), 3)
+}
+
+func TestResubmit(t *testing.T) {
+	params.SetAddressPrefixes()
+	contract := newAddress()
+	admin := newAddress()
+	wasmKeeper := &contractRecorder{admins: map[string]string{contract.String(): admin.String()}}
+	k, contractManagerKeeper, ctx := keepertest.ContractFailuresKeeper(t, wasmKeeper)
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: "icacontroller-" + contract.String(), SourceChannel: "channel-0"}
+
+	wasmKeeper.err = errors.New("contract failed")
+	ctx = keeper.WithPendingSudos(ctx)
+	for ; packet.Sequence <= 2; packet.Sequence++ {
+		_, err := k.SudoTimeout(ctx, contract, packet)
+		require.Error(t, err)
+		k.AddContractFailure(ctx, packet.SourceChannel, contract.String(), packet.Sequence, "timeout")
+	}
+	failedSudo, found := k.GetFailedSudo(ctx, contract, 0)
+	require.True(t, found)
+
+	err := k.Resubmit(ctx, contract, contract, 7)
+	require.ErrorIs(t, err, types.ErrFailureNotFound)
+	err = k.Resubmit(ctx, newAddress(), contract, 0)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the failure is kept while the callback fails
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = k.Resubmit(ctx, admin, contract, 0)
+	require.ErrorIs(t, err, types.ErrResubmitFailed)
+	require.Equal(t, failedSudo.Msg, wasmKeeper.msgs[len(wasmKeeper.msgs)-1])
+	require.Empty(t, ctx.EventManager().Events())
+	_, found = k.GetFailedSudo(ctx, contract, 0)
+	require.True(t, found)
+
+	// the callback is limited to the resubmit gas limit
+	wasmKeeper.err = nil
+	wasmKeeper.gas = types.DefaultParams().ResubmitGasLimit + 1
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	err = k.Resubmit(ctx, contract, contract, 0)
+	require.ErrorIs(t, err, types.ErrResubmitFailed)
+	require.Contains(t, err.Error(), "out of gas")
+	// the limit is charged along the store accesses
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), types.DefaultParams().ResubmitGasLimit)
+	require.Less(t, ctx.GasMeter().GasConsumed(), types.DefaultParams().ResubmitGasLimit+10_000)
+
+	wasmKeeper.gas = 0
+	err = k.Resubmit(ctx, admin, contract, 0)
+	require.NoError(t, err)
+	require.Len(t, ctx.EventManager().Events(), 2)
+	require.Equal(t, "sudo", ctx.EventManager().Events()[0].Type)
+	require.Equal(t, types.EventTypeResubmitFailure, ctx.EventManager().Events()[1].Type)
+	_, found = k.GetFailedSudo(ctx, contract, 0)
+	require.False(t, found)
+	failures := contractManagerKeeper.GetAllFailures(ctx)
+	require.Len(t, failures, 1)
+	require.Equal(t, uint64(1), failures[0].Id)
+
+	err = k.Resubmit(ctx, contract, contract, 1)
+	require.NoError(t, err)
+	require.Empty(t, k.GetAllFailedSudos(ctx))
+	require.Empty(t, contractManagerKeeper.GetAllFailures(ctx))
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) ResubmitFailure(goCtx context.Context, msg *types.MsgResubmitFailure) (*types.MsgResubmitFailureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Resubmit(ctx, sender, contract, msg.FailureId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResubmitFailureResponse{}, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contractfailures/types"
+)
+
+// Resubmit calls back the contract with the sudo message of one of its failed callbacks, on behalf of the
+// contract itself or its admin. The call is limited to the resubmit gas limit, and the failure is removed
+// only if it succeeds.
+func (k Keeper) Resubmit(ctx sdk.Context, sender, contract sdk.AccAddress, failureID uint64) error {
+	failedSudo, found := k.GetFailedSudo(ctx, contract, failureID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrFailureNotFound, "failure %d of %s", failureID, contract)
+	}
+	if !sender.Equals(contract) {
+		contractInfo := k.wasmKeeper.GetContractInfo(ctx, contract)
+		if contractInfo == nil || contractInfo.Admin != sender.String() {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the contract nor its admin", sender)
+		}
+	}
+
+	if err := k.sudo(ctx, contract, failedSudo.Msg); err != nil {
+		return sdkerrors.Wrap(types.ErrResubmitFailed, err.Error())
+	}
+
+	k.DeleteFailedSudo(ctx, contract, failureID)
+	k.contractManagerKeeper.RemoveContractFailure(ctx, contract.String(), failureID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResubmitFailure,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyFailureID, strconv.FormatUint(failureID, 10)),
+		),
+	)
+	return nil
+}
+
+// sudo calls the contract in a cached context limited to the resubmit gas limit, whose changes and events
+// are kept only if the call succeeds.
+func (k Keeper) sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) (err error) {
+	gasMeter := sdk.NewGasMeter(k.GetParams(ctx).ResubmitGasLimit)
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, outOfGas.Descriptor)
+		}
+
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "resubmitted callback")
+	}()
+
+	if _, err = k.wasmKeeper.Sudo(cacheCtx, contract, msg); err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,13 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/contractfailures sentinel errors.
+var (
+	ErrFailureNotFound = sdkerrors.Register(ModuleName, 1, "no resubmittable failure")
+	ErrResubmitFailed  = sdkerrors.Register(ModuleName, 2, "resubmitted callback failed")
+)
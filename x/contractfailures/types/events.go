@@ -0,0 +1,8 @@
+package types
+
+const (
+	EventTypeResubmitFailure = "resubmit_failure"
+
+	AttributeKeyContract  = "contract"
+	AttributeKeyFailureID = "failure_id"
+)
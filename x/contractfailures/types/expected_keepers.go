@@ -0,0 +1,28 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	contractmanagertypes "github.com/neutron-org/neutron/x/contractmanager/types"
+)
+
+// ContractManagerKeeper defines the expected contract manager keeper calling back the contracts and recording
+// their failed callbacks.
+type ContractManagerKeeper interface {
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	AddContractFailure(ctx sdk.Context, channelID string, address string, ackID uint64, ackType string)
+	RemoveContractFailure(ctx sdk.Context, address string, failureID uint64)
+	GetNextFailureIDKey(ctx sdk.Context, address string) uint64
+	SudoResponse(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, msg []byte) ([]byte, error)
+	SudoError(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, details string) ([]byte, error)
+	SudoTimeout(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet) ([]byte, error)
+	SudoOnChanOpenAck(ctx sdk.Context, contractAddress sdk.AccAddress, details contractmanagertypes.OpenAckDetails) ([]byte, error)
+}
+
+// WasmKeeper defines the expected wasm keeper to call back the contracts again and tell their admin.
+type WasmKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
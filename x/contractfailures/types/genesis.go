@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, failedSudos []FailedSudo) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		FailedSudos: failedSudos,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []FailedSudo{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.FailedSudos))
+	for _, failedSudo := range gs.FailedSudos {
+		contract, err := sdk.AccAddressFromBech32(failedSudo.Address)
+		if err != nil {
+			return fmt.Errorf("invalid contract address %s: %w", failedSudo.Address, err)
+		}
+		if len(failedSudo.Msg) == 0 {
+			return fmt.Errorf("empty sudo message of failure %d of %s", failedSudo.FailureId, failedSudo.Address)
+		}
+
+		key := string(FailedSudoKey(contract, failedSudo.FailureId))
+		if seen[key] {
+			return fmt.Errorf("duplicate failure %d of %s", failedSudo.FailureId, failedSudo.Address)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "contractfailures"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
+
+// FailedSudoPrefix is the store prefix of the sudo messages of the failed contract callbacks.
+var FailedSudoPrefix = []byte{0x01}
+
+// FailedSudoKey returns the store key of the sudo message of a failed callback of a contract.
+func FailedSudoKey(contract sdk.AccAddress, failureID uint64) []byte {
+	key := append(append([]byte{}, FailedSudoPrefix...), address.MustLengthPrefix(contract)...)
+	return append(key, sdk.Uint64ToBigEndian(failureID)...)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+const (
+	TypeMsgResubmitFailure = "resubmit_failure"
+)
+
+var _ legacytx.LegacyMsg = &MsgResubmitFailure{}
+
+// NewMsgResubmitFailure creates a new MsgResubmitFailure instance.
+func NewMsgResubmitFailure(sender, contract sdk.AccAddress, failureID uint64) *MsgResubmitFailure {
+	return &MsgResubmitFailure{Sender: sender.String(), Contract: contract.String(), FailureId: failureID}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgResubmitFailure) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgResubmitFailure) Type() string { return TypeMsgResubmitFailure }
+
+// ValidateBasic runs stateless checks on the message.
+func (msg MsgResubmitFailure) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgResubmitFailure) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgResubmitFailure.
+func (msg MsgResubmitFailure) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
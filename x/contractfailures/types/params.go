@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	KeyResubmitGasLimit     = []byte("ResubmitGasLimit")
+	DefaultResubmitGasLimit = uint64(1_000_000)
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the contractfailures module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(resubmitGasLimit uint64) Params {
+	return Params{
+		ResubmitGasLimit: resubmitGasLimit,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultResubmitGasLimit)
+}
+
+// ParamSetPairs get the params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyResubmitGasLimit, &p.ResubmitGasLimit, validateResubmitGasLimit),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	return validateResubmitGasLimit(p.ResubmitGasLimit)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateResubmitGasLimit(v interface{}) error {
+	gasLimit, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if gasLimit == 0 {
+		return fmt.Errorf("resubmit gas limit must be positive")
+	}
+
+	return nil
+}
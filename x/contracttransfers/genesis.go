@@ -0,0 +1,20 @@
+package contracttransfers
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the contracttransfers module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, transfer := range genState.Transfers {
+		k.SetTransfer(ctx, transfer)
+	}
+}
+
+// ExportGenesis returns the contracttransfers module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllTransfers(ctx))
+}
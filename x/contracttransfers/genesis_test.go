@@ -0,0 +1,132 @@
+package contracttransfers_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers"
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+)
+
+func TestGenesis(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	settled := types.NewTransfer("channel-0", 3, sender, "settled")
+	settled.Status = types.STATUS_TIMEOUT
+	settled.SettledHeight = 5
+	genesisState := *types.NewGenesisState([]types.Transfer{
+		types.NewTransfer("channel-0", 1, sender, "pending"),
+		types.NewTransfer("channel-0", 2, sender, ""),
+		settled,
+	})
+	require.NoError(t, genesisState.Validate())
+
+	contracttransfers.InitGenesis(ctx, app.ContractTransfersKeeper, genesisState)
+	got := contracttransfers.ExportGenesis(ctx, app.ContractTransfersKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
+
+	// the settled transfers are pruned after the retention window
+	app.ContractTransfersKeeper.PruneSettledTransfers(ctx.WithBlockHeight(5 + types.SettledTransferRetention))
+	require.Equal(t, genesisState.Transfers[:2], app.ContractTransfersKeeper.GetAllTransfers(ctx))
+}
+
+func TestGenesisValidate(t *testing.T) {
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	transfer := types.NewTransfer("channel-0", 1, sender, "")
+	settled := transfer
+	settled.Status = types.STATUS_ACKNOWLEDGED
+	settled.SettledHeight = 1
+
+	testCases := []struct {
+		title     string
+		transfers func() []types.Transfer
+		valid     bool
+	}{
+		{
+			title:     "default genesis",
+			transfers: func() []types.Transfer { return nil },
+			valid:     true,
+		},
+		{
+			title:     "duplicate transfer",
+			transfers: func() []types.Transfer { return []types.Transfer{transfer, transfer} },
+		},
+		{
+			title: "invalid channel",
+			transfers: func() []types.Transfer {
+				invalid := transfer
+				invalid.Channel = "c"
+				return []types.Transfer{invalid}
+			},
+		},
+		{
+			title: "zero sequence",
+			transfers: func() []types.Transfer {
+				invalid := transfer
+				invalid.Sequence = 0
+				return []types.Transfer{invalid}
+			},
+		},
+		{
+			title: "invalid sender",
+			transfers: func() []types.Transfer {
+				invalid := transfer
+				invalid.Sender = "sender"
+				return []types.Transfer{invalid}
+			},
+		},
+		{
+			title:     "settled transfer",
+			transfers: func() []types.Transfer { return []types.Transfer{settled} },
+			valid:     true,
+		},
+		{
+			title: "unknown status",
+			transfers: func() []types.Transfer {
+				invalid := settled
+				invalid.Status = 4
+				return []types.Transfer{invalid}
+			},
+		},
+		{
+			title: "settled transfer without settle height",
+			transfers: func() []types.Transfer {
+				invalid := settled
+				invalid.SettledHeight = 0
+				return []types.Transfer{invalid}
+			},
+		},
+		{
+			title: "negative settle height",
+			transfers: func() []types.Transfer {
+				invalid := settled
+				invalid.SettledHeight = -1
+				return []types.Transfer{invalid}
+			},
+		},
+		{
+			title: "pending transfer with settle height",
+			transfers: func() []types.Transfer {
+				invalid := transfer
+				invalid.SettledHeight = 1
+				return []types.Transfer{invalid}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := types.NewGenesisState(tc.transfers()).Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
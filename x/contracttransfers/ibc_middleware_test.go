@@ -0,0 +1,74 @@
+package contracttransfers_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers"
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+)
+
+// settleApp is a transfer application failing the acknowledgements and timeouts if told so.
+type settleApp struct {
+	porttypes.IBCModule
+
+	err error
+}
+
+func (a settleApp) OnAcknowledgementPacket(_ sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	return a.err
+}
+
+func (a settleApp) OnTimeoutPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	return a.err
+}
+
+func TestIBCMiddlewareSettlesTransfers(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx := keepertest.ContractTransfersKeeper(t, nil)
+	middleware := contracttransfers.NewIBCMiddleware(settleApp{}, *k)
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{SourceChannel: "channel-0", Sequence: sequence}
+	}
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		k.SetTransfer(ctx, types.NewTransfer("channel-0", sequence, sender, ""))
+	}
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet(1), ack.Acknowledgement(), nil))
+	errAck := channeltypes.NewErrorAcknowledgement(errors.New("rejected"))
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet(2), errAck.Acknowledgement(), nil))
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, packet(3), nil))
+
+	for sequence, status := range map[uint64]types.Status{1: types.STATUS_ACKNOWLEDGED, 2: types.STATUS_ERROR, 3: types.STATUS_TIMEOUT} {
+		got, found := k.GetTransfer(ctx, "channel-0", sequence)
+		require.True(t, found)
+		require.Equal(t, status, got.Status)
+	}
+}
+
+func TestIBCMiddlewareKeepsTransfersOnFailure(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx := keepertest.ContractTransfersKeeper(t, nil)
+	middleware := contracttransfers.NewIBCMiddleware(settleApp{err: errors.New("refund failed")}, *k)
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	packet := channeltypes.Packet{SourceChannel: "channel-0", Sequence: 1}
+	k.SetTransfer(ctx, types.NewTransfer("channel-0", 1, sender, ""))
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.Error(t, middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil))
+	require.Error(t, middleware.OnTimeoutPacket(ctx, packet, nil))
+
+	got, found := k.GetTransfer(ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, types.STATUS_PENDING, got.Status)
+}
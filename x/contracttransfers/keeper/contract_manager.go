@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+
+	transfertypes "github.com/neutron-org/neutron/x/transfer/types"
+)
+
+// The keeper wraps the contract manager for the transfer module, which calls back the contracts through it
+// once their transfers are acknowledged or timed out. The callbacks of the transfers sent with a callback id
+// carry it along the packet.
+var _ transfertypes.ContractManagerKeeper = Keeper{}
+
+// HasContractInfo implements the contract manager keeper.
+func (k Keeper) HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	return k.contractManagerKeeper.HasContractInfo(ctx, contractAddress)
+}
+
+// AddContractFailure implements the contract manager keeper.
+func (k Keeper) AddContractFailure(ctx sdk.Context, channelID string, address string, ackID uint64, ackType string) {
+	k.contractManagerKeeper.AddContractFailure(ctx, channelID, address, ackID, ackType)
+}
+
+// SudoResponse implements the contract manager keeper.
+func (k Keeper) SudoResponse(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, msg []byte) ([]byte, error) {
+	callbackID, found := k.callbackID(ctx, request)
+	if !found {
+		return k.contractManagerKeeper.SudoResponse(ctx, senderAddress, request, msg)
+	}
+
+	var sudo types.MessageResponse
+	sudo.Response.Request = request
+	sudo.Response.Data = msg
+	sudo.Response.CallbackID = callbackID
+
+	return k.sudo(ctx, senderAddress, request, sudo)
+}
+
+// SudoError implements the contract manager keeper.
+func (k Keeper) SudoError(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, details string) ([]byte, error) {
+	callbackID, found := k.callbackID(ctx, request)
+	if !found {
+		return k.contractManagerKeeper.SudoError(ctx, senderAddress, request, details)
+	}
+
+	var sudo types.MessageError
+	sudo.Error.Request = request
+	sudo.Error.Details = details
+	sudo.Error.CallbackID = callbackID
+
+	return k.sudo(ctx, senderAddress, request, sudo)
+}
+
+// SudoTimeout implements the contract manager keeper.
+func (k Keeper) SudoTimeout(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet) ([]byte, error) {
+	callbackID, found := k.callbackID(ctx, request)
+	if !found {
+		return k.contractManagerKeeper.SudoTimeout(ctx, senderAddress, request)
+	}
+
+	var sudo types.MessageTimeout
+	sudo.Timeout.Request = request
+	sudo.Timeout.CallbackID = callbackID
+
+	return k.sudo(ctx, senderAddress, request, sudo)
+}
+
+// callbackID returns the callback id of the pending transfer of the packet, if sent by a contract with one.
+func (k Keeper) callbackID(ctx sdk.Context, request channeltypes.Packet) (string, bool) {
+	transfer, found := k.GetTransfer(ctx, request.SourceChannel, request.Sequence)
+	if !found || transfer.CallbackId == "" {
+		return "", false
+	}
+
+	return transfer.CallbackId, true
+}
+
+func (k Keeper) sudo(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, sudo interface{}) ([]byte, error) {
+	msg, err := json.Marshal(sudo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sudo message: %v", err)
+	}
+
+	return k.contractManagerKeeper.Sudo(ctx, senderAddress, request, msg)
+}
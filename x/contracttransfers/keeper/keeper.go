@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	Keeper struct {
+		cdc      codec.BinaryCodec
+		storeKey sdk.StoreKey
+
+		contractManagerKeeper types.ContractManagerKeeper
+	}
+)
+
+// NewKeeper creates the contracttransfers keeper, wrapping the contract manager keeper
+// the transfer module calls back the contracts through.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	cmk types.ContractManagerKeeper,
+) *Keeper {
+	return &Keeper{
+		cdc:                   cdc,
+		storeKey:              storeKey,
+		contractManagerKeeper: cmk,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetTransfer stores a transfer sent by a contract. The settled transfers are indexed by their settle height,
+// to be pruned once their retention window is over.
+func (k Keeper) SetTransfer(ctx sdk.Context, transfer types.Transfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TransferKey(transfer.Channel, transfer.Sequence), k.cdc.MustMarshal(&transfer))
+	if transfer.Status != types.STATUS_PENDING {
+		store.Set(types.SettledTransferKey(transfer.SettledHeight, transfer.Channel, transfer.Sequence), []byte{})
+	}
+}
+
+// GetTransfer returns the transfer sent by a contract on a channel with a packet sequence.
+func (k Keeper) GetTransfer(ctx sdk.Context, channel string, sequence uint64) (types.Transfer, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferKey(channel, sequence))
+	if bz == nil {
+		return types.Transfer{}, false
+	}
+
+	var transfer types.Transfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// DeleteTransfer removes the transfer sent by a contract on a channel with a packet sequence.
+func (k Keeper) DeleteTransfer(ctx sdk.Context, channel string, sequence uint64) {
+	transfer, found := k.GetTransfer(ctx, channel, sequence)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TransferKey(channel, sequence))
+	if transfer.Status != types.STATUS_PENDING {
+		store.Delete(types.SettledTransferKey(transfer.SettledHeight, channel, sequence))
+	}
+}
+
+// GetAllTransfers returns all transfers sent by contracts, pending and settled.
+func (k Keeper) GetAllTransfers(ctx sdk.Context) []types.Transfer {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	transfers := []types.Transfer{}
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.Transfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+)
+
+// sudoRecorder records the sudo messages built by the keeper and the callbacks left to the contract manager.
+type sudoRecorder struct {
+	msgs      [][]byte
+	forwarded []string
+}
+
+func (r *sudoRecorder) HasContractInfo(_ sdk.Context, _ sdk.AccAddress) bool {
+	return true
+}
+
+func (r *sudoRecorder) AddContractFailure(_ sdk.Context, _ string, _ string, _ uint64, _ string) {}
+
+func (r *sudoRecorder) SudoResponse(_ sdk.Context, _ sdk.AccAddress, _ channeltypes.Packet, _ []byte) ([]byte, error) {
+	r.forwarded = append(r.forwarded, "response")
+	return nil, nil
+}
+
+func (r *sudoRecorder) SudoError(_ sdk.Context, _ sdk.AccAddress, _ channeltypes.Packet, _ string) ([]byte, error) {
+	r.forwarded = append(r.forwarded, "error")
+	return nil, nil
+}
+
+func (r *sudoRecorder) SudoTimeout(_ sdk.Context, _ sdk.AccAddress, _ channeltypes.Packet) ([]byte, error) {
+	r.forwarded = append(r.forwarded, "timeout")
+	return nil, nil
+}
+
+func (r *sudoRecorder) Sudo(_ sdk.Context, _ sdk.AccAddress, _ channeltypes.Packet, msg []byte) ([]byte, error) {
+	r.msgs = append(r.msgs, msg)
+	return nil, nil
+}
+
+func TestTransfers(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx := keepertest.ContractTransfersKeeper(t, &sudoRecorder{})
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	_, found := k.GetTransfer(ctx, "channel-0", 1)
+	require.False(t, found)
+
+	first := types.NewTransfer("channel-0", 1, sender, "")
+	second := types.NewTransfer("channel-0", 2, sender, "second")
+	other := types.NewTransfer("channel-10", 1, sender, "other")
+	k.SetTransfer(ctx, other)
+	k.SetTransfer(ctx, second)
+	k.SetTransfer(ctx, first)
+
+	got, found := k.GetTransfer(ctx, "channel-0", 2)
+	require.True(t, found)
+	require.Equal(t, second, got)
+	require.Equal(t, []types.Transfer{first, second, other}, k.GetAllTransfers(ctx))
+
+	k.DeleteTransfer(ctx, "channel-0", 2)
+	_, found = k.GetTransfer(ctx, "channel-0", 2)
+	require.False(t, found)
+	require.Equal(t, []types.Transfer{first, other}, k.GetAllTransfers(ctx))
+}
+
+func TestSettleTransfer(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx := keepertest.ContractTransfersKeeper(t, &sudoRecorder{})
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	// the transfers not sent by contracts are ignored
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.SettleTransfer(ctx, "channel-0", 1, types.STATUS_ACKNOWLEDGED)
+	require.Empty(t, ctx.EventManager().Events())
+
+	ctx = ctx.WithBlockHeight(10)
+	k.SetTransfer(ctx, types.NewTransfer("channel-0", 1, sender, "refund-7"))
+	k.SetTransfer(ctx, types.NewTransfer("channel-0", 2, sender, ""))
+	k.SettleTransfer(ctx, "channel-0", 1, types.STATUS_ERROR)
+
+	got, found := k.GetTransfer(ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, types.STATUS_ERROR, got.Status)
+	require.Equal(t, int64(10), got.SettledHeight)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	event := ctx.EventManager().Events()[0]
+	require.Equal(t, types.EventTypeTransferSettled, event.Type)
+	require.Contains(t, event.Attributes, sdk.NewAttribute(types.AttributeKeyCallbackID, "refund-7").ToKVPair())
+	require.Contains(t, event.Attributes, sdk.NewAttribute(types.AttributeKeyStatus, "error").ToKVPair())
+
+	// the settled transfers keep their outcome
+	k.SettleTransfer(ctx.WithBlockHeight(11), "channel-0", 1, types.STATUS_TIMEOUT)
+	got, _ = k.GetTransfer(ctx, "channel-0", 1)
+	require.Equal(t, types.STATUS_ERROR, got.Status)
+	require.Equal(t, int64(10), got.SettledHeight)
+	require.Len(t, ctx.EventManager().Events(), 1)
+}
+
+func TestPruneSettledTransfers(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx := keepertest.ContractTransfersKeeper(t, &sudoRecorder{})
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		k.SetTransfer(ctx, types.NewTransfer("channel-0", sequence, sender, ""))
+	}
+	k.SettleTransfer(ctx.WithBlockHeight(10), "channel-0", 1, types.STATUS_ACKNOWLEDGED)
+	k.SettleTransfer(ctx.WithBlockHeight(20), "channel-0", 2, types.STATUS_TIMEOUT)
+
+	// kept for the retention window
+	k.PruneSettledTransfers(ctx.WithBlockHeight(10 + types.SettledTransferRetention - 1))
+	require.Len(t, k.GetAllTransfers(ctx), 3)
+
+	k.PruneSettledTransfers(ctx.WithBlockHeight(10 + types.SettledTransferRetention))
+	_, found := k.GetTransfer(ctx, "channel-0", 1)
+	require.False(t, found)
+	require.Len(t, k.GetAllTransfers(ctx), 2)
+
+	// the pending transfers are never pruned
+	k.PruneSettledTransfers(ctx.WithBlockHeight(20 + types.SettledTransferRetention))
+	got, found := k.GetTransfer(ctx, "channel-0", 3)
+	require.True(t, found)
+	require.Equal(t, []types.Transfer{got}, k.GetAllTransfers(ctx))
+
+	// the pruning index is removed along the deleted transfers
+	k.SettleTransfer(ctx.WithBlockHeight(30), "channel-0", 3, types.STATUS_ERROR)
+	k.DeleteTransfer(ctx, "channel-0", 3)
+	k.SetTransfer(ctx, types.NewTransfer("channel-0", 3, sender, ""))
+	k.PruneSettledTransfers(ctx.WithBlockHeight(30 + types.SettledTransferRetention))
+	require.Len(t, k.GetAllTransfers(ctx), 1)
+}
+
+func TestCallbackID(t *testing.T) {
+	params.SetAddressPrefixes()
+	contractManager := &sudoRecorder{}
+	k, ctx := keepertest.ContractTransfersKeeper(t, contractManager)
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-0", Sequence: sequence}
+	}
+
+	// the callbacks of the transfers without a callback id are left to the contract manager
+	k.SetTransfer(ctx, types.NewTransfer("channel-0", 1, sender.String(), ""))
+	for _, sequence := range []uint64{1, 2} {
+		_, err := k.SudoResponse(ctx, sender, packet(sequence), []byte("data"))
+		require.NoError(t, err)
+		_, err = k.SudoError(ctx, sender, packet(sequence), "rejected")
+		require.NoError(t, err)
+		_, err = k.SudoTimeout(ctx, sender, packet(sequence))
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"response", "error", "timeout", "response", "error", "timeout"}, contractManager.forwarded)
+	require.Empty(t, contractManager.msgs)
+
+	k.SetTransfer(ctx, types.NewTransfer("channel-0", 3, sender.String(), "refund-7"))
+	_, err := k.SudoResponse(ctx, sender, packet(3), []byte("data"))
+	require.NoError(t, err)
+	_, err = k.SudoError(ctx, sender, packet(3), "rejected")
+	require.NoError(t, err)
+	_, err = k.SudoTimeout(ctx, sender, packet(3))
+	require.NoError(t, err)
+	require.Len(t, contractManager.msgs, 3)
+
+	var response types.MessageResponse
+	require.NoError(t, json.Unmarshal(contractManager.msgs[0], &response))
+	require.Equal(t, packet(3), response.Response.Request)
+	require.Equal(t, []byte("data"), response.Response.Data)
+	require.Equal(t, "refund-7", response.Response.CallbackID)
+
+	var sudoErr types.MessageError
+	require.NoError(t, json.Unmarshal(contractManager.msgs[1], &sudoErr))
+	require.Equal(t, packet(3), sudoErr.Error.Request)
+	require.Equal(t, "rejected", sudoErr.Error.Details)
+	require.Equal(t, "refund-7", sudoErr.Error.CallbackID)
+
+	var timeout types.MessageTimeout
+	require.NoError(t, json.Unmarshal(contractManager.msgs[2], &timeout))
+	require.Equal(t, packet(3), timeout.Timeout.Request)
+	require.Equal(t, "refund-7", timeout.Timeout.CallbackID)
+}
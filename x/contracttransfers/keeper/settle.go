@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Nolus-Protocol/nolus-core/x/contracttransfers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SettleTransfer records the outcome of a transfer sent by a contract, acknowledged or timed out on a channel, once
+// the transfer module has called back the contract with it. The settled transfer is kept for the retention window,
+// so that the contract may query its status.
+func (k Keeper) SettleTransfer(ctx sdk.Context, channel string, sequence uint64, status types.Status) {
+	transfer, found := k.GetTransfer(ctx, channel, sequence)
+	if !found || transfer.Status != types.STATUS_PENDING {
+		// not sent by a contract, or already settled
+		return
+	}
+
+	transfer.Status = status
+	transfer.SettledHeight = ctx.BlockHeight()
+	k.SetTransfer(ctx, transfer)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferSettled,
+			sdk.NewAttribute(types.AttributeKeyChannel, transfer.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(transfer.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyCallbackID, transfer.CallbackId),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.Name()),
+		),
+	)
+}
+
+// PruneSettledTransfers removes the transfers settled SettledTransferRetention blocks ago or earlier.
+func (k Keeper) PruneSettledTransfers(ctx sdk.Context) {
+	retainedHeight := ctx.BlockHeight() - types.SettledTransferRetention + 1
+	if retainedHeight <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	settledStore := prefix.NewStore(store, types.SettledTransferPrefix)
+	iterator := settledStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(retainedHeight)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		// the settled key is the settle height followed by the transfer key without its prefix
+		store.Delete(append(append([]byte{}, types.TransferPrefix...), key[8:]...))
+		settledStore.Delete(key)
+	}
+}
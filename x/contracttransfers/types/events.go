@@ -0,0 +1,11 @@
+package types
+
+// Contracttransfers module event types.
+const (
+	EventTypeTransferSettled = "transfer_settled"
+
+	AttributeKeyChannel    = "channel"
+	AttributeKeySequence   = "sequence"
+	AttributeKeyCallbackID = "callback_id"
+	AttributeKeyStatus     = "status"
+)
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// ContractManagerKeeper defines the expected contract manager keeper calling back the contracts sending
+// transfers and recording their failed callbacks.
+type ContractManagerKeeper interface {
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	AddContractFailure(ctx sdk.Context, channelID string, address string, ackID uint64, ackType string)
+	SudoResponse(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, msg []byte) ([]byte, error)
+	SudoError(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet, details string) ([]byte, error)
+	SudoTimeout(ctx sdk.Context, senderAddress sdk.AccAddress, request channeltypes.Packet) ([]byte, error)
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, request channeltypes.Packet, msg []byte) ([]byte, error)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(transfers []Transfer) *GenesisState {
+	return &GenesisState{
+		Transfers: transfers,
+	}
+}
+
+// DefaultGenesis returns the default contracttransfers genesis state with no transfers.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]Transfer{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Transfers))
+	for _, transfer := range gs.Transfers {
+		key := string(TransferKey(transfer.Channel, transfer.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate transfer %d on channel %s", transfer.Sequence, transfer.Channel)
+		}
+		seen[key] = true
+
+		if err := transfer.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the packet identifiers, the sender, the status and the settle height of the transfer.
+func (m Transfer) Validate() error {
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return err
+	}
+
+	if m.Sequence == 0 {
+		return fmt.Errorf("invalid sequence of transfer on channel %s", m.Channel)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid transfer sender %s: %w", m.Sender, err)
+	}
+
+	if len(m.CallbackId) > MaxCallbackIDLength {
+		return fmt.Errorf("callback id of transfer %d on channel %s longer than %d", m.Sequence, m.Channel, MaxCallbackIDLength)
+	}
+
+	if _, known := statusNames[m.Status]; !known {
+		return fmt.Errorf("unknown status %d of transfer %d on channel %s", m.Status, m.Sequence, m.Channel)
+	}
+
+	if (m.Status == STATUS_PENDING) != (m.SettledHeight == 0) {
+		return fmt.Errorf("settle height %d of transfer %d on channel %s does not match its status %s", m.SettledHeight, m.Sequence, m.Channel, m.Status.Name())
+	}
+
+	if m.SettledHeight < 0 {
+		return fmt.Errorf("negative settle height of transfer %d on channel %s", m.Sequence, m.Channel)
+	}
+
+	return nil
+}
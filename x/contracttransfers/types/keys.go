@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "contracttransfers"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// TransferPrefix is the store prefix of the transfers sent by contracts.
+	TransferPrefix = []byte{0x01}
+	// SettledTransferPrefix is the store prefix of the settled transfers by settle height, to prune them.
+	SettledTransferPrefix = []byte{0x02}
+)
+
+// TransferKey returns the store key of the transfer sent on a channel with a packet sequence.
+func TransferKey(channel string, sequence uint64) []byte {
+	key := append(append([]byte{}, TransferPrefix...), []byte(channel+"/")...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// SettledTransferKey returns the store key of the transfer sent on a channel with a packet sequence,
+// settled at a height. The settled transfers are ordered by height.
+func SettledTransferKey(height int64, channel string, sequence uint64) []byte {
+	key := append(append([]byte{}, SettledTransferPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, TransferKey(channel, sequence)[len(TransferPrefix):]...)
+}
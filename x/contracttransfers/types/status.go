@@ -0,0 +1,33 @@
+package types
+
+const (
+	// MaxCallbackIDLength is the maximum length of the callback id of a transfer.
+	MaxCallbackIDLength = 128
+
+	// SettledTransferRetention is the number of blocks a settled transfer is kept for, so that its sender may
+	// query the outcome. It is about a week of 6 seconds blocks.
+	SettledTransferRetention int64 = 100_800
+)
+
+var statusNames = map[Status]string{
+	STATUS_PENDING:      "pending",
+	STATUS_ACKNOWLEDGED: "acknowledged",
+	STATUS_ERROR:        "error",
+	STATUS_TIMEOUT:      "timeout",
+}
+
+// NewTransfer creates a pending transfer.
+func NewTransfer(channel string, sequence uint64, sender, callbackID string) Transfer {
+	return Transfer{
+		Channel:    channel,
+		Sequence:   sequence,
+		Sender:     sender,
+		CallbackId: callbackID,
+		Status:     STATUS_PENDING,
+	}
+}
+
+// Name returns the name of the status as known by the contracts.
+func (s Status) Name() string {
+	return statusNames[s]
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// MessageResponse is passed to the sudo() entrypoint of a contract once a transfer with a callback id
+// is acknowledged. It extends the response message of the contract manager with the callback id.
+type MessageResponse struct {
+	Response struct {
+		Request    channeltypes.Packet `json:"request"`
+		Data       []byte              `json:"data"`
+		CallbackID string              `json:"callback_id"`
+	} `json:"response"`
+}
+
+// MessageError is passed to the sudo() entrypoint of a contract once a transfer with a callback id
+// is rejected. It extends the error message of the contract manager with the callback id.
+type MessageError struct {
+	Error struct {
+		Request    channeltypes.Packet `json:"request"`
+		Details    string              `json:"details"`
+		CallbackID string              `json:"callback_id"`
+	} `json:"error"`
+}
+
+// MessageTimeout is passed to the sudo() entrypoint of a contract once a transfer with a callback id
+// times out. It extends the timeout message of the contract manager with the callback id.
+type MessageTimeout struct {
+	Timeout struct {
+		Request    channeltypes.Packet `json:"request"`
+		CallbackID string              `json:"callback_id"`
+	} `json:"timeout"`
+}
@@ -0,0 +1,578 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contracttransfers/transfer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Status is the outcome of an outgoing ICS-20 transfer.
+type Status int32
+
+const (
+	// STATUS_PENDING is the status of a transfer neither acknowledged nor timed out yet.
+	STATUS_PENDING Status = 0
+	// STATUS_ACKNOWLEDGED is the status of a transfer acknowledged by the counterparty chain.
+	STATUS_ACKNOWLEDGED Status = 1
+	// STATUS_ERROR is the status of a transfer rejected by the counterparty chain, the tokens are refunded.
+	STATUS_ERROR Status = 2
+	// STATUS_TIMEOUT is the status of a transfer timed out, the tokens are refunded.
+	STATUS_TIMEOUT Status = 3
+)
+
+var Status_name = map[int32]string{
+	0: "STATUS_PENDING",
+	1: "STATUS_ACKNOWLEDGED",
+	2: "STATUS_ERROR",
+	3: "STATUS_TIMEOUT",
+}
+
+var Status_value = map[string]int32{
+	"STATUS_PENDING":      0,
+	"STATUS_ACKNOWLEDGED": 1,
+	"STATUS_ERROR":        2,
+	"STATUS_TIMEOUT":      3,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe38f3ed3e7b6c55, []int{0}
+}
+
+// Transfer is an ICS-20 transfer sent by a contract, kept for a retention window once settled.
+type Transfer struct {
+	// channel is the source channel of the transfer packet.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// sequence is the sequence of the transfer packet, unique per channel.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sender is the address of the contract sending the transfer.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// callback_id is the contract supplied identifier echoed back when the transfer is settled.
+	CallbackId string `protobuf:"bytes,4,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Status     Status `protobuf:"varint,5,opt,name=status,proto3,enum=contracttransfers.Status" json:"status,omitempty"`
+	// settled_height is the height the transfer was acknowledged or timed out at, zero while pending.
+	SettledHeight int64 `protobuf:"varint,7,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height,omitempty"`
+}
+
+func (m *Transfer) Reset()         { *m = Transfer{} }
+func (m *Transfer) String() string { return proto.CompactTextString(m) }
+func (*Transfer) ProtoMessage()    {}
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe38f3ed3e7b6c55, []int{0}
+}
+func (m *Transfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Transfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Transfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Transfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transfer.Merge(m, src)
+}
+func (m *Transfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Transfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transfer proto.InternalMessageInfo
+
+func (m *Transfer) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *Transfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Transfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Transfer) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *Transfer) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return STATUS_PENDING
+}
+
+func (m *Transfer) GetSettledHeight() int64 {
+	if m != nil {
+		return m.SettledHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("contracttransfers.Status", Status_name, Status_value)
+	proto.RegisterType((*Transfer)(nil), "contracttransfers.Transfer")
+}
+
+func init() { proto.RegisterFile("contracttransfers/transfer.proto", fileDescriptor_fe38f3ed3e7b6c55) }
+
+var fileDescriptor_fe38f3ed3e7b6c55 = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x18, 0x85, 0x33, 0x6d, 0x6f, 0xda, 0x3b, 0xf7, 0x5a, 0xe2, 0x28, 0x1a, 0xbb, 0x88, 0x41, 0x10,
+	0x82, 0x60, 0x82, 0xba, 0x17, 0xaa, 0x0d, 0xb5, 0xa8, 0x69, 0x49, 0x53, 0x14, 0x37, 0x25, 0x9d,
+	0x8c, 0x49, 0x31, 0x66, 0x6a, 0x66, 0x02, 0xfa, 0x06, 0x2e, 0x7d, 0x07, 0x5f, 0xc6, 0x65, 0xc1,
+	0x8d, 0x4b, 0x69, 0x5f, 0x44, 0x3a, 0x4d, 0x45, 0xa8, 0xbb, 0xff, 0x9c, 0xf3, 0x1d, 0x18, 0xe6,
+	0x40, 0x1d, 0xd3, 0x84, 0xa7, 0x3e, 0xe6, 0x3c, 0xf5, 0x13, 0x76, 0x4b, 0x52, 0x66, 0x2d, 0x2e,
+	0x73, 0x94, 0x52, 0x4e, 0xd1, 0xea, 0x12, 0x51, 0x5b, 0x0f, 0x69, 0x48, 0x45, 0x6a, 0xcd, 0xae,
+	0x39, 0xb8, 0xf3, 0x0e, 0x60, 0xc5, 0xcb, 0x19, 0xa4, 0xc2, 0x32, 0x8e, 0xfc, 0x24, 0x21, 0xb1,
+	0x0a, 0x74, 0x60, 0xfc, 0x75, 0x17, 0x12, 0xd5, 0x60, 0x85, 0x91, 0x87, 0x8c, 0x24, 0x98, 0xa8,
+	0x05, 0x1d, 0x18, 0x25, 0xf7, 0x5b, 0xa3, 0x0d, 0x28, 0x33, 0x92, 0x04, 0x24, 0x55, 0x8b, 0xa2,
+	0x94, 0x2b, 0xb4, 0x0d, 0xff, 0x61, 0x3f, 0x8e, 0x07, 0x3e, 0xbe, 0xeb, 0x0f, 0x03, 0xb5, 0x24,
+	0x42, 0xb8, 0xb0, 0x5a, 0x01, 0x3a, 0x80, 0x32, 0xe3, 0x3e, 0xcf, 0x98, 0xfa, 0x47, 0x07, 0x46,
+	0xf5, 0x70, 0xcb, 0x5c, 0x7a, 0xb5, 0xd9, 0x15, 0x80, 0x9b, 0x83, 0x68, 0x17, 0x56, 0x19, 0xe1,
+	0x3c, 0x26, 0x41, 0x3f, 0x22, 0xc3, 0x30, 0xe2, 0x6a, 0x59, 0x07, 0x46, 0xd1, 0x5d, 0xc9, 0xdd,
+	0x33, 0x61, 0xee, 0xf9, 0x50, 0x9e, 0x17, 0x11, 0x82, 0xd5, 0xae, 0x57, 0xf7, 0x7a, 0xdd, 0x7e,
+	0xc7, 0x76, 0x1a, 0x2d, 0xa7, 0xa9, 0x48, 0x68, 0x13, 0xae, 0xe5, 0x5e, 0xfd, 0xf4, 0xdc, 0x69,
+	0x5f, 0x5d, 0xd8, 0x8d, 0xa6, 0xdd, 0x50, 0x00, 0x52, 0xe0, 0xff, 0x3c, 0xb0, 0x5d, 0xb7, 0xed,
+	0x2a, 0x85, 0x1f, 0x75, 0xaf, 0x75, 0x69, 0xb7, 0x7b, 0x9e, 0x52, 0xac, 0x95, 0x9e, 0x5f, 0x35,
+	0xe9, 0xe4, 0xfa, 0x6d, 0xa2, 0x81, 0xf1, 0x44, 0x03, 0x9f, 0x13, 0x0d, 0xbc, 0x4c, 0x35, 0x69,
+	0x3c, 0xd5, 0xa4, 0x8f, 0xa9, 0x26, 0xdd, 0x1c, 0x87, 0x43, 0x1e, 0x65, 0x03, 0x13, 0xd3, 0x7b,
+	0xcb, 0xa1, 0x71, 0xc6, 0xf6, 0x3b, 0xb3, 0xaf, 0xc6, 0x34, 0xb6, 0x12, 0x21, 0x31, 0x4d, 0x89,
+	0xf5, 0x68, 0xfd, 0x32, 0xe2, 0xd3, 0x88, 0xb0, 0x81, 0x2c, 0x96, 0x39, 0xfa, 0x1a, 0x00, 0x28,
+	0xae, 0x52, 0xe8, 0xe6, 0x01, 0x00, 0x00,
+}
+
+func (m *Transfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettledHeight != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.SettledHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Transfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTransfer(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTransfer(uint64(m.Status))
+	}
+	if m.SettledHeight != 0 {
+		n += 1 + sovTransfer(uint64(m.SettledHeight))
+	}
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Transfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+			}
+			m.SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransfer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,191 @@
+package ibchooks
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/Nolus-Protocol/nolus-core/custom/util"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware executes a contract with the tokens of the incoming ICS-20 transfers whose memo requests it
+// under the "wasm" key. The tokens are received by an intermediate sender derived from the channel and the
+// sender on the counterparty chain, which executes the contract with them. The transfer is acknowledged with
+// the contract's response, so the sending contract on the counterparty chain gets it in its acknowledgement
+// callback, or with an error refunding the tokens when the contract fails. The contracts on Nolus requesting a
+// callback under the "ibc_callback" key of the memo of their transfers are called back once the transfer is
+// acknowledged or times out.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and the underlying application.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. A transfer requesting an invalid hook, or whose contract
+// fails, is answered with an error acknowledgement reverting the receipt of the tokens.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// leave the rejection of the malformed packets to the transfer application
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	hook, contract, requested, err := types.ParseWasmHook(data.Memo, data.Receiver)
+	if !requested {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// the tokens are received by the intermediate sender, the memo is not passed to the applications below
+	sender := types.IntermediateSender(packet.GetDestChannel(), data.Sender)
+	received := data
+	received.Receiver = sender.String()
+	received.Memo = ""
+	receivedPacket := packet
+	receivedPacket.Data = received.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, receivedPacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	funds := sdk.NewCoins(sdk.NewCoin(util.ReceivedDenom(packet, data), amount))
+	result, err := im.keeper.ExecuteHook(ctx, contract, sender, hook.Msg, funds)
+	if err != nil {
+		im.emitHookEvent(ctx, packet, hook, sender, err)
+		// the tokens received by the intermediate sender are reverted along with the error acknowledgement
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrHookFailed, err.Error()))
+	}
+	im.emitHookEvent(ctx, packet, hook, sender, nil)
+
+	bz, err := json.Marshal(types.HookAcknowledgement{ContractResult: result, IBCAck: ack.Acknowledgement()})
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The contract having requested a callback is called
+// back with the acknowledgement once the applications below have refunded the tokens of a failed transfer.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgement(ctx, packet, acknowledgement)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The contract having requested a callback is called back
+// once the applications below have refunded the tokens.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeout(ctx, packet)
+	return nil
+}
+
+func (im IBCMiddleware) emitHookEvent(ctx sdk.Context, packet channeltypes.Packet, hook types.WasmHook, sender sdk.AccAddress, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.GetDestChannel()),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, hook.Contract),
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeWasmHook, attributes...))
+}
@@ -0,0 +1,175 @@
+package ibchooks_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/stretchr/testify/require"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+)
+
+// setupTransferPath connects two Nolus chains with a transfer channel.
+func setupTransferPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	ibctesting.DefaultTestingAppInit = nolusapp.SetupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)))
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	coordinator.Setup(path)
+
+	return coordinator, path
+}
+
+func getApp(chain *ibctesting.TestChain) *nolusapp.App {
+	return chain.App.(*nolusapp.App)
+}
+
+// instantiateReflect instantiates on the chain the reflect contract, which dispatches the messages it is given.
+func instantiateReflect(t *testing.T, coordinator *ibctesting.Coordinator, chain *ibctesting.TestChain) sdk.AccAddress {
+	wasmCode, err := os.ReadFile("../../wasmbinding/testdata/reflect.wasm")
+	require.NoError(t, err)
+
+	ctx := chain.GetContext()
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&getApp(chain).WasmKeeper)
+	creator := chain.SenderAccount.GetAddress()
+	codeID, _, err := contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, creator, creator, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+	coordinator.CommitBlock(chain)
+
+	return contract
+}
+
+// transferWithMemo sends the tokens from the sender of chain A through the path to the receiver on chain B. The transfer
+// is sent by the ibc transfer keeper, as the neutron transfer wrapper serving the transfer messages drops the memo.
+func transferWithMemo(coordinator *ibctesting.Coordinator, path *ibctesting.Path, amount int64, receiver, memo string) channeltypes.Packet {
+	from := path.EndpointA
+	msg := transfertypes.NewMsgTransfer(from.ChannelConfig.PortID, from.ChannelID, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		from.Chain.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0)
+	msg.Memo = memo
+
+	ctx := from.Chain.GetContext()
+	_, err := getApp(from.Chain).TransferKeeper.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(from.Chain.T, err)
+	coordinator.CommitBlock(from.Chain)
+
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	require.NoError(from.Chain.T, err)
+	return packet
+}
+
+// relay receives the packet on chain B and relays its acknowledgement back to chain A.
+func relay(t *testing.T, path *ibctesting.Path, packet channeltypes.Packet) channeltypes.Acknowledgement {
+	require.NoError(t, path.EndpointB.UpdateClient())
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.AcknowledgePacket(packet, bz))
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+	return ack
+}
+
+func wasmMemo(contract sdk.AccAddress, msg string) string {
+	return fmt.Sprintf(`{"wasm":{"contract":"%s","msg":%s}}`, contract, msg)
+}
+
+func TestWasmHook(t *testing.T) {
+	coordinator, path := setupTransferPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	contract := instantiateReflect(t, coordinator, chainB)
+	voucher := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+	recipient := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// the reflect contract keeps the funds it is executed with
+	msg := fmt.Sprintf(`{"send":{"to":"%s","amount":"60"}}`, recipient)
+	packet := transferWithMemo(coordinator, path, 100, contract.String(), wasmMemo(contract, msg))
+	ack := relay(t, path, packet)
+	require.True(t, ack.Success())
+
+	var result types.HookAcknowledgement
+	require.NoError(t, json.Unmarshal(ack.GetResult(), &result))
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), result.IBCAck)
+
+	bankKeeper := getApp(chainB).BankKeeper
+	ctx := chainB.GetContext()
+	require.Equal(t, sdk.NewInt(100), bankKeeper.GetBalance(ctx, contract, voucher).Amount)
+	sender := types.IntermediateSender(path.EndpointB.ChannelID, chainA.SenderAccount.GetAddress().String())
+	require.True(t, bankKeeper.GetAllBalances(ctx, sender).IsZero())
+}
+
+func TestWasmHookRefund(t *testing.T) {
+	coordinator, path := setupTransferPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	contract := instantiateReflect(t, coordinator, chainB)
+	other := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	voucher := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+	sender := chainA.SenderAccount.GetAddress()
+	balance := getApp(chainA).BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	testCases := []struct {
+		title    string
+		receiver string
+		memo     string
+	}{
+		{
+			title:    "failing contract",
+			receiver: contract.String(),
+			memo:     wasmMemo(contract, `{"unknown":{}}`),
+		},
+		{
+			title:    "receiver other than the contract",
+			receiver: other.String(),
+			memo:     wasmMemo(contract, fmt.Sprintf(`{"send":{"to":"%s","amount":"1"}}`, other)),
+		},
+		{
+			title:    "malformed hook",
+			receiver: contract.String(),
+			memo:     `{"wasm":{"contract":"nolus1"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			packet := transferWithMemo(coordinator, path, 100, tc.receiver, tc.memo)
+			ack := relay(t, path, packet)
+			require.False(t, ack.Success())
+
+			require.Equal(t, balance, getApp(chainA).BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom))
+			require.True(t, getApp(chainB).BankKeeper.GetSupply(chainB.GetContext(), voucher).IsZero())
+		})
+	}
+}
+
+func TestTransferWithoutHook(t *testing.T) {
+	coordinator, path := setupTransferPath(t)
+	chainB := path.EndpointB.Chain
+	receiver := chainB.SenderAccount.GetAddress()
+	voucher := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+
+	packet := transferWithMemo(coordinator, path, 100, receiver.String(), `{"note":"no hook"}`)
+	require.True(t, relay(t, path, packet).Success())
+	require.Equal(t, sdk.NewInt(100), getApp(chainB).BankKeeper.GetBalance(chainB.GetContext(), receiver, voucher).Amount)
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// OnAcknowledgement calls back the contract recorded for the acknowledged packet, if any, with the acknowledgement.
+func (k Keeper) OnAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	var ack channeltypes.Acknowledgement
+	success := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+
+	var msg types.MessageIBCLifecycleComplete
+	msg.IBCLifecycleComplete.IBCAck = &types.IBCAck{
+		Channel:  packet.GetSourceChannel(),
+		Sequence: packet.GetSequence(),
+		Ack:      string(acknowledgement),
+		Success:  success,
+	}
+	k.callback(ctx, packet, msg)
+}
+
+// OnTimeout calls back the contract recorded for the timed out packet, if any.
+func (k Keeper) OnTimeout(ctx sdk.Context, packet channeltypes.Packet) {
+	var msg types.MessageIBCLifecycleComplete
+	msg.IBCLifecycleComplete.IBCTimeout = &types.IBCTimeout{
+		Channel:  packet.GetSourceChannel(),
+		Sequence: packet.GetSequence(),
+	}
+	k.callback(ctx, packet, msg)
+}
+
+// callback removes the callback of the settled packet and calls back its contract. A failure of the contract
+// does not fail the settlement of the packet, which has already refunded the tokens if needed.
+func (k Keeper) callback(ctx sdk.Context, packet channeltypes.Packet, msg types.MessageIBCLifecycleComplete) {
+	contract, found := k.GetCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	k.DeleteCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	bz, err := json.Marshal(msg)
+	if err == nil {
+		err = k.sudo(ctx, contract, bz)
+	}
+	if err != nil {
+		k.Logger(ctx).Debug("callback: contract failed", "contract", contract, "error", err)
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.GetSourceChannel()),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeIBCCallback, attributes...))
+}
+
+// sudo calls the contract in a cached context limited to the callback gas limit, whose changes and events
+// are kept only if the call succeeds.
+func (k Keeper) sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) (err error) {
+	gasMeter := sdk.NewGasMeter(types.CallbackGasLimit)
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, outOfGas.Descriptor)
+		}
+
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "ibc callback")
+	}()
+
+	if _, err = k.contractKeeper.Sudo(cacheCtx, contract, msg); err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket implements the ICS4Wrapper interface. The sending contract of a transfer requesting a callback in
+// its memo is recorded under the channel and sequence of the packet. A transfer requesting an invalid callback
+// fails, so the tokens stay with the sender.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// leave the rejection of the malformed packets to the channel
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	contract, requested, err := types.ParseCallback(data.Memo, data.Sender)
+	if !requested {
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+	if err != nil {
+		return err
+	}
+	if !k.wasmKeeper.HasContractInfo(ctx, contract) {
+		return sdkerrors.Wrapf(types.ErrInvalidCallback, "%s is not a contract", contract)
+	}
+
+	if err := k.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	k.SetCallback(ctx, packet.GetSourceChannel(), packet.GetSequence(), contract)
+	return nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (k Keeper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+)
+
+type (
+	Keeper struct {
+		storeKey sdk.StoreKey
+
+		contractKeeper types.ContractKeeper
+		wasmKeeper     types.WasmKeeper
+		ics4Wrapper    porttypes.ICS4Wrapper
+	}
+)
+
+// NewKeeper creates the ibchooks keeper. It wraps the ICS4Wrapper the transfer keeper sends its packets through,
+// so the contracts requesting a callback in the memo of their transfers are recorded when the packet is sent.
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	contractKeeper types.ContractKeeper,
+	wasmKeeper types.WasmKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper,
+) *Keeper {
+	return &Keeper{
+		storeKey:       storeKey,
+		contractKeeper: contractKeeper,
+		wasmKeeper:     wasmKeeper,
+		ics4Wrapper:    ics4Wrapper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetCallback stores the contract to call back once the packet sent on the channel with the sequence is settled.
+func (k Keeper) SetCallback(ctx sdk.Context, channelID string, sequence uint64, contract sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CallbackKey(channelID, sequence), contract)
+}
+
+// GetCallback returns the contract to call back once the packet sent on the channel with the sequence is settled.
+func (k Keeper) GetCallback(ctx sdk.Context, channelID string, sequence uint64) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CallbackKey(channelID, sequence))
+	if bz == nil {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// DeleteCallback removes the callback of a settled packet.
+func (k Keeper) DeleteCallback(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CallbackKey(channelID, sequence))
+}
+
+// ExecuteHook executes the contract of a hook on behalf of its intermediate sender, with the received funds.
+func (k Keeper) ExecuteHook(ctx sdk.Context, contract, sender sdk.AccAddress, msg []byte, funds sdk.Coins) ([]byte, error) {
+	return k.contractKeeper.Execute(ctx, contract, sender, msg, funds)
+}
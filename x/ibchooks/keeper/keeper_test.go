@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+)
+
+const channel = "channel-0"
+
+var (
+	contract = sdk.AccAddress([]byte("contract____________"))
+	account  = sdk.AccAddress([]byte("account_____________"))
+)
+
+// contractKeeper records the sudo messages of the callbacks, failing them or consuming the given gas.
+type contractKeeper struct {
+	msgs [][]byte
+	fail bool
+	gas  uint64
+}
+
+func (c *contractKeeper) Execute(_ sdk.Context, _, _ sdk.AccAddress, _ []byte, _ sdk.Coins) ([]byte, error) {
+	return nil, nil
+}
+
+func (c *contractKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	c.msgs = append(c.msgs, msg)
+	ctx.GasMeter().ConsumeGas(c.gas, "sudo")
+	if c.fail {
+		return nil, errors.New("sudo failed")
+	}
+	return nil, nil
+}
+
+type wasmKeeper struct{}
+
+func (wasmKeeper) HasContractInfo(_ sdk.Context, addr sdk.AccAddress) bool {
+	return addr.Equals(contract)
+}
+
+// ics4Wrapper records the sent packets.
+type ics4Wrapper struct {
+	sent []exported.PacketI
+}
+
+func (w *ics4Wrapper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI) error {
+	w.sent = append(w.sent, packet)
+	return nil
+}
+
+func (w *ics4Wrapper) WriteAcknowledgement(_ sdk.Context, _ *capabilitytypes.Capability, _ exported.PacketI, _ exported.Acknowledgement) error {
+	return nil
+}
+
+func (w *ics4Wrapper) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	return transfertypes.Version, true
+}
+
+func transferPacket(sequence uint64, sender sdk.AccAddress, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData("unls", "100", sender.String(), "osmo1receiver")
+	data.Memo = memo
+	return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, channel, transfertypes.PortID, "channel-1",
+		clienttypes.NewHeight(0, 100), 0)
+}
+
+func callbackMemo(addr sdk.AccAddress) string {
+	return `{"ibc_callback":"` + addr.String() + `"}`
+}
+
+func TestSendPacket(t *testing.T) {
+	params.SetAddressPrefixes()
+	wrapper := &ics4Wrapper{}
+	k, ctx := keepertest.IBCHooksKeeper(t, &contractKeeper{}, wasmKeeper{}, wrapper)
+
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket(1, contract, callbackMemo(contract))))
+	got, found := k.GetCallback(ctx, channel, 1)
+	require.True(t, found)
+	require.Equal(t, contract, got)
+
+	// the transfers requesting no callback are sent as they are
+	require.NoError(t, k.SendPacket(ctx, nil, transferPacket(2, account, `{"note":"no callback"}`)))
+	_, found = k.GetCallback(ctx, channel, 2)
+	require.False(t, found)
+	require.Len(t, wrapper.sent, 2)
+
+	// the callbacks must be requested by the sending contract
+	require.ErrorIs(t, k.SendPacket(ctx, nil, transferPacket(3, account, callbackMemo(contract))), types.ErrInvalidCallback)
+	require.ErrorIs(t, k.SendPacket(ctx, nil, transferPacket(4, account, callbackMemo(account))), types.ErrInvalidCallback)
+	require.Len(t, wrapper.sent, 2)
+	_, found = k.GetCallback(ctx, channel, 3)
+	require.False(t, found)
+}
+
+func TestOnAcknowledgement(t *testing.T) {
+	params.SetAddressPrefixes()
+	contractKeeper := &contractKeeper{}
+	k, ctx := keepertest.IBCHooksKeeper(t, contractKeeper, wasmKeeper{}, &ics4Wrapper{})
+
+	packet := transferPacket(1, contract, callbackMemo(contract))
+	require.NoError(t, k.SendPacket(ctx, nil, packet))
+
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()
+	k.OnAcknowledgement(ctx, packet, ack)
+	require.Len(t, contractKeeper.msgs, 1)
+
+	var msg types.MessageIBCLifecycleComplete
+	require.NoError(t, json.Unmarshal(contractKeeper.msgs[0], &msg))
+	require.Nil(t, msg.IBCLifecycleComplete.IBCTimeout)
+	require.Equal(t, &types.IBCAck{Channel: channel, Sequence: 1, Ack: string(ack), Success: false}, msg.IBCLifecycleComplete.IBCAck)
+
+	// the contract is called back once
+	_, found := k.GetCallback(ctx, channel, 1)
+	require.False(t, found)
+	k.OnAcknowledgement(ctx, packet, ack)
+	require.Len(t, contractKeeper.msgs, 1)
+
+	// the packets without a callback call back no contract
+	k.OnAcknowledgement(ctx, transferPacket(2, account, ""), channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement())
+	require.Len(t, contractKeeper.msgs, 1)
+}
+
+func TestOnTimeout(t *testing.T) {
+	params.SetAddressPrefixes()
+	contractKeeper := &contractKeeper{}
+	k, ctx := keepertest.IBCHooksKeeper(t, contractKeeper, wasmKeeper{}, &ics4Wrapper{})
+
+	packet := transferPacket(1, contract, callbackMemo(contract))
+	require.NoError(t, k.SendPacket(ctx, nil, packet))
+
+	k.OnTimeout(ctx, packet)
+	require.Len(t, contractKeeper.msgs, 1)
+
+	var msg types.MessageIBCLifecycleComplete
+	require.NoError(t, json.Unmarshal(contractKeeper.msgs[0], &msg))
+	require.Nil(t, msg.IBCLifecycleComplete.IBCAck)
+	require.Equal(t, &types.IBCTimeout{Channel: channel, Sequence: 1}, msg.IBCLifecycleComplete.IBCTimeout)
+
+	_, found := k.GetCallback(ctx, channel, 1)
+	require.False(t, found)
+}
+
+func TestFailedCallback(t *testing.T) {
+	params.SetAddressPrefixes()
+
+	for _, tc := range []struct {
+		title          string
+		contractKeeper *contractKeeper
+		gasUsed        uint64
+	}{
+		{
+			title:          "failing contract",
+			contractKeeper: &contractKeeper{fail: true},
+		},
+		{
+			title:          "contract out of gas",
+			contractKeeper: &contractKeeper{gas: types.CallbackGasLimit + 1},
+			gasUsed:        types.CallbackGasLimit,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			k, ctx := keepertest.IBCHooksKeeper(t, tc.contractKeeper, wasmKeeper{}, &ics4Wrapper{})
+			ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+
+			packet := transferPacket(1, contract, callbackMemo(contract))
+			require.NoError(t, k.SendPacket(ctx, nil, packet))
+
+			// a failing contract neither fails the timeout nor keeps its callback
+			gasBefore := ctx.GasMeter().GasConsumed()
+			require.NotPanics(t, func() { k.OnTimeout(ctx, packet) })
+			_, found := k.GetCallback(ctx, channel, 1)
+			require.False(t, found)
+			require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, tc.gasUsed)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Equal(t, types.EventTypeIBCCallback, events[0].Type)
+			for _, attr := range events[0].Attributes {
+				if string(attr.Key) == types.AttributeKeySuccess {
+					require.Equal(t, "false", string(attr.Value))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CallbackGasLimit is the gas available to a contract called back with the outcome of its transfer.
+const CallbackGasLimit uint64 = 1_000_000
+
+type callbackMetadata struct {
+	Callback *string `json:"ibc_callback"`
+}
+
+// ParseCallback returns the contract requesting in the memo of an outgoing ICS-20 transfer to be called back once
+// the transfer is acknowledged or times out, e.g. {"ibc_callback":"nolus1..."}. The memos without an "ibc_callback"
+// key, including the ones not being JSON objects, request none. The contract must be the sender of the transfer,
+// so no one can have another contract called back with the outcome of their transfer.
+func ParseCallback(memo string, sender string) (sdk.AccAddress, bool, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		return nil, false, nil
+	}
+	if _, ok := raw["ibc_callback"]; !ok {
+		return nil, false, nil
+	}
+
+	var metadata callbackMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil || metadata.Callback == nil {
+		return nil, true, sdkerrors.Wrapf(ErrInvalidCallback, "malformed callback: %s", memo)
+	}
+
+	contract, err := sdk.AccAddressFromBech32(*metadata.Callback)
+	if err != nil {
+		return nil, true, sdkerrors.Wrapf(ErrInvalidCallback, "invalid contract address: %s", err)
+	}
+	if *metadata.Callback != sender {
+		return nil, true, sdkerrors.Wrapf(ErrInvalidCallback, "the sender %s is not the contract %s", sender, *metadata.Callback)
+	}
+
+	return contract, true, nil
+}
+
+// MessageIBCLifecycleComplete is the sudo message calling back a contract with the outcome of its transfer,
+// either its acknowledgement or its timeout.
+type MessageIBCLifecycleComplete struct {
+	IBCLifecycleComplete struct {
+		IBCAck     *IBCAck     `json:"ibc_ack,omitempty"`
+		IBCTimeout *IBCTimeout `json:"ibc_timeout,omitempty"`
+	} `json:"ibc_lifecycle_complete"`
+}
+
+// IBCAck is the acknowledgement of a transfer of a contract, which is refunded unless it succeeds.
+type IBCAck struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Ack      string `json:"ack"`
+	Success  bool   `json:"success"`
+}
+
+// IBCTimeout is the timeout of a refunded transfer of a contract.
+type IBCTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
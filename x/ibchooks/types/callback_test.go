@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+)
+
+func TestParseCallback(t *testing.T) {
+	params.SetAddressPrefixes()
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+
+	testCases := []struct {
+		title     string
+		memo      string
+		sender    string
+		requested bool
+		valid     bool
+	}{
+		{
+			title:  "empty memo",
+			memo:   "",
+			sender: contract,
+			valid:  true,
+		},
+		{
+			title:  "plain text memo",
+			memo:   "thanks",
+			sender: contract,
+			valid:  true,
+		},
+		{
+			title:  "hook for the receiver",
+			memo:   `{"wasm":{"contract":"` + contract + `","msg":{}}}`,
+			sender: contract,
+			valid:  true,
+		},
+		{
+			title:     "callback",
+			memo:      `{"ibc_callback":"` + contract + `"}`,
+			sender:    contract,
+			requested: true,
+			valid:     true,
+		},
+		{
+			title:     "callback along a hook for the receiver",
+			memo:      `{"wasm":{"contract":"osmo1","msg":{}},"ibc_callback":"` + contract + `"}`,
+			sender:    contract,
+			requested: true,
+			valid:     true,
+		},
+		{
+			title:     "malformed callback",
+			memo:      `{"ibc_callback":{"contract":"` + contract + `"}}`,
+			sender:    contract,
+			requested: true,
+		},
+		{
+			title:     "invalid contract",
+			memo:      `{"ibc_callback":"nolus1"}`,
+			sender:    "nolus1",
+			requested: true,
+		},
+		{
+			title:     "sender other than the contract",
+			memo:      `{"ibc_callback":"` + contract + `"}`,
+			sender:    sdk.AccAddress([]byte("sender______________")).String(),
+			requested: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			addr, requested, err := types.ParseCallback(tc.memo, tc.sender)
+			require.Equal(t, tc.requested, requested)
+			if !tc.valid {
+				require.ErrorIs(t, err, types.ErrInvalidCallback)
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.requested {
+				require.Equal(t, contract, addr.String())
+			}
+		})
+	}
+}
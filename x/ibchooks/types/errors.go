@@ -0,0 +1,14 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/ibchooks sentinel errors.
+var (
+	ErrInvalidHook     = sdkerrors.Register(ModuleName, 1, "invalid wasm hook")
+	ErrHookFailed      = sdkerrors.Register(ModuleName, 2, "wasm hook execution failed")
+	ErrInvalidCallback = sdkerrors.Register(ModuleName, 3, "invalid ibc callback")
+)
@@ -0,0 +1,14 @@
+package types
+
+// Ibchooks event types.
+const (
+	EventTypeWasmHook    = "wasm_hook"
+	EventTypeIBCCallback = "ibc_callback"
+
+	AttributeKeyChannel  = "channel"
+	AttributeKeySequence = "sequence"
+	AttributeKeyContract = "contract"
+	AttributeKeySender   = "sender"
+	AttributeKeySuccess  = "success"
+	AttributeKeyError    = "error"
+)
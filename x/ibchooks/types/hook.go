@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WasmHook is a contract execution requested in the memo of an ICS-20 transfer, e.g.
+// {"wasm":{"contract":"nolus1...","msg":{"open_lease":{}}}}.
+type WasmHook struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+type hookMetadata struct {
+	Wasm *WasmHook `json:"wasm"`
+}
+
+// HookAcknowledgement is the result of the acknowledgement of an ICS-20 transfer executing a contract. It returns
+// the data of the contract response to the sender on the counterparty chain along with the transfer's acknowledgement.
+type HookAcknowledgement struct {
+	ContractResult []byte `json:"contract_result"`
+	IBCAck         []byte `json:"ibc_ack"`
+}
+
+// ParseWasmHook returns the contract execution requested in the memo of a transfer to the given receiver. The memos
+// without a "wasm" key, including the ones not being JSON objects, request none. The contract must be the receiver
+// of the transfer, so the senders cannot pass the tokens to a contract they did not name.
+func ParseWasmHook(memo string, receiver string) (WasmHook, sdk.AccAddress, bool, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		return WasmHook{}, nil, false, nil
+	}
+	if _, ok := raw["wasm"]; !ok {
+		return WasmHook{}, nil, false, nil
+	}
+
+	var metadata hookMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil || metadata.Wasm == nil {
+		return WasmHook{}, nil, true, sdkerrors.Wrapf(ErrInvalidHook, "malformed hook: %s", memo)
+	}
+	hook := *metadata.Wasm
+
+	contract, err := sdk.AccAddressFromBech32(hook.Contract)
+	if err != nil {
+		return WasmHook{}, nil, true, sdkerrors.Wrapf(ErrInvalidHook, "invalid contract address: %s", err)
+	}
+	if hook.Contract != receiver {
+		return WasmHook{}, nil, true, sdkerrors.Wrapf(ErrInvalidHook, "the receiver %s is not the contract %s", receiver, hook.Contract)
+	}
+
+	var msg map[string]json.RawMessage
+	if err := json.Unmarshal(hook.Msg, &msg); err != nil {
+		return WasmHook{}, nil, true, sdkerrors.Wrap(ErrInvalidHook, "the msg is not a JSON object")
+	}
+
+	return hook, contract, true, nil
+}
+
+// IntermediateSender returns the account receiving the tokens of a hook and executing the contract with them, derived
+// from the Nolus channel and the sender on the counterparty chain. The contracts cannot take it for a Nolus account of
+// the sender, nor for the intermediate sender of anyone else.
+func IntermediateSender(channelID, sender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(ModuleName, []byte(fmt.Sprintf("%s/%s", channelID, sender))))
+}
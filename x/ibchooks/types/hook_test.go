@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks/types"
+)
+
+func TestParseWasmHook(t *testing.T) {
+	params.SetAddressPrefixes()
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+
+	testCases := []struct {
+		title     string
+		memo      string
+		receiver  string
+		requested bool
+		msg       string
+		valid     bool
+	}{
+		{
+			title:    "empty memo",
+			memo:     "",
+			receiver: contract,
+			valid:    true,
+		},
+		{
+			title:    "plain text memo",
+			memo:     "thanks",
+			receiver: contract,
+			valid:    true,
+		},
+		{
+			title:    "memo of another middleware",
+			memo:     `{"forward":{"receiver":"osmo1","channel":"channel-1"}}`,
+			receiver: contract,
+			valid:    true,
+		},
+		{
+			title:     "hook",
+			memo:      `{"wasm":{"contract":"` + contract + `","msg":{"open_lease":{"currency":"OSMO"}}}}`,
+			receiver:  contract,
+			requested: true,
+			msg:       `{"open_lease":{"currency":"OSMO"}}`,
+			valid:     true,
+		},
+		{
+			title:     "malformed hook",
+			memo:      `{"wasm":"` + contract + `"}`,
+			receiver:  contract,
+			requested: true,
+		},
+		{
+			title:     "invalid contract",
+			memo:      `{"wasm":{"contract":"nolus1","msg":{}}}`,
+			receiver:  "nolus1",
+			requested: true,
+		},
+		{
+			title:     "receiver other than the contract",
+			memo:      `{"wasm":{"contract":"` + contract + `","msg":{}}}`,
+			receiver:  sdk.AccAddress([]byte("receiver____________")).String(),
+			requested: true,
+		},
+		{
+			title:     "msg not an object",
+			memo:      `{"wasm":{"contract":"` + contract + `","msg":"open_lease"}}`,
+			receiver:  contract,
+			requested: true,
+		},
+		{
+			title:     "missing msg",
+			memo:      `{"wasm":{"contract":"` + contract + `"}}`,
+			receiver:  contract,
+			requested: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			hook, addr, requested, err := types.ParseWasmHook(tc.memo, tc.receiver)
+			require.Equal(t, tc.requested, requested)
+			if !tc.valid {
+				require.ErrorIs(t, err, types.ErrInvalidHook)
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.requested {
+				require.Equal(t, contract, addr.String())
+				require.JSONEq(t, tc.msg, string(hook.Msg))
+			}
+		})
+	}
+}
+
+func TestIntermediateSender(t *testing.T) {
+	sender := types.IntermediateSender("channel-0", "osmo1sender")
+	require.Equal(t, sender, types.IntermediateSender("channel-0", "osmo1sender"))
+	require.NotEqual(t, sender, types.IntermediateSender("channel-1", "osmo1sender"))
+	require.NotEqual(t, sender, types.IntermediateSender("channel-0", "osmo1other"))
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName defines the name the intermediate senders of the hooks are derived with.
+	ModuleName = "ibchooks"
+
+	// StoreKey defines the store key of the callbacks awaiting the acknowledgement of their packet. The store keys
+	// may not prefix one another, so it cannot start with the one of the ibc module.
+	StoreKey = "hooks-for-ibc"
+)
+
+// CallbackPrefix is the store prefix of the contracts to call back once their transfer is acknowledged or times out.
+var CallbackPrefix = []byte{0x01}
+
+// CallbackKey returns the store key of the callback of a transfer, by the channel and sequence of its packet.
+func CallbackKey(channelID string, sequence uint64) []byte {
+	key := append(append([]byte{}, CallbackPrefix...), []byte(channelID+"/")...)
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
@@ -0,0 +1,128 @@
+package icacallbacks
+
+import (
+	"encoding/json"
+
+	"github.com/Nolus-Protocol/nolus-core/x/icacallbacks/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	ictxtypes "github.com/neutron-org/neutron/x/interchaintxs/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware calls back the contract owning an interchain account once its channel is open, in place of
+// the wrapped interchain transactions application, with the address of the account on the host chain and the
+// metadata of the channel. All other callbacks are passed to the wrapped application.
+type IBCMiddleware struct {
+	app        porttypes.IBCModule
+	wasmKeeper types.WasmKeeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the wasm keeper and the underlying application.
+func NewIBCMiddleware(app porttypes.IBCModule, wasmKeeper types.WasmKeeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:        app,
+		wasmKeeper: wasmKeeper,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface. A contract failing the callback fails the handshake.
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	icaOwner, err := ictxtypes.ICAOwnerFromPort(portID)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to get ica owner from port")
+	}
+
+	sudo, err := types.NewMessageOnChanOpenAck(portID, channelID, counterpartyChannelID, counterpartyVersion)
+	if err != nil {
+		return err
+	}
+	msg, err := json.Marshal(sudo)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "failed to marshal MessageOnChanOpenAck: %v", err)
+	}
+	if _, err := im.wasmKeeper.Sudo(ctx, icaOwner.GetContract(), msg); err != nil {
+		return sdkerrors.Wrap(err, "failed to Sudo the contract OnChanOpenAck")
+	}
+
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	return im.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
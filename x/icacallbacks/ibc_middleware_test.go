@@ -0,0 +1,65 @@
+package icacallbacks_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/Nolus-Protocol/nolus-core/x/icacallbacks"
+
+	ictxtypes "github.com/neutron-org/neutron/x/interchaintxs/types"
+)
+
+// wasmKeeper records the sudo calls to the contracts.
+type wasmKeeper struct {
+	contract sdk.AccAddress
+	msg      []byte
+	err      error
+}
+
+func (k *wasmKeeper) Sudo(_ sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	k.contract, k.msg = contractAddress, msg
+	return nil, k.err
+}
+
+func TestOnChanOpenAck(t *testing.T) {
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	portID, err := icatypes.NewControllerPortID(ictxtypes.NewICAOwnerFromAddress(contract, "account").String())
+	require.NoError(t, err)
+	metadata := icatypes.NewMetadata(icatypes.Version, "connection-0", "connection-1", "cosmos1host", icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+
+	keeper := &wasmKeeper{}
+	// the interchain transactions application is not called back
+	middleware := icacallbacks.NewIBCMiddleware(porttypes.IBCModule(nil), keeper)
+	require.NoError(t, middleware.OnChanOpenAck(sdk.Context{}, portID, "channel-0", "channel-1", version))
+	require.Equal(t, contract, keeper.contract)
+
+	var sudo map[string]map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(keeper.msg, &sudo))
+	openAck := sudo["open_ack"]
+	require.JSONEq(t, `"`+portID+`"`, string(openAck["port_id"]))
+	require.JSONEq(t, `"channel-0"`, string(openAck["channel_id"]))
+	require.JSONEq(t, `"channel-1"`, string(openAck["counterparty_channel_id"]))
+	require.JSONEq(t, string(mustMarshal(t, version)), string(openAck["counterparty_version"]))
+	require.JSONEq(t, `"cosmos1host"`, string(openAck["counterparty_address"]))
+	require.JSONEq(t, version, string(openAck["metadata"]))
+
+	keeper.err = errors.New("contract failed")
+	require.ErrorIs(t, middleware.OnChanOpenAck(sdk.Context{}, portID, "channel-0", "channel-1", version), keeper.err)
+
+	require.ErrorIs(t, middleware.OnChanOpenAck(sdk.Context{}, portID, "channel-0", "channel-1", "not a version"), icatypes.ErrUnknownDataType)
+	require.Error(t, middleware.OnChanOpenAck(sdk.Context{}, "transfer", "channel-0", "channel-1", version))
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	bz, err := json.Marshal(v)
+	require.NoError(t, err)
+	return bz
+}
@@ -0,0 +1,6 @@
+package types
+
+const (
+	// ModuleName defines the name of the interchain accounts callbacks.
+	ModuleName = "icacallbacks"
+)
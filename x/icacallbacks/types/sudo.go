@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+
+	contractmanagertypes "github.com/neutron-org/neutron/x/contractmanager/types"
+)
+
+// MessageOnChanOpenAck is the sudo message sent to a contract once the channel of its interchain account is open.
+type MessageOnChanOpenAck struct {
+	OpenAck OpenAckDetails `json:"open_ack"`
+}
+
+// OpenAckDetails extends the details of the interchain transactions module with the address of the account
+// on the host chain and the metadata of the channel, as parsed from the counterparty version.
+type OpenAckDetails struct {
+	contractmanagertypes.OpenAckDetails
+	CounterpartyAddress string            `json:"counterparty_address"`
+	Metadata            icatypes.Metadata `json:"metadata"`
+}
+
+// NewMessageOnChanOpenAck creates the sudo message of an open channel given its counterparty version.
+func NewMessageOnChanOpenAck(portID, channelID, counterpartyChannelID, counterpartyVersion string) (MessageOnChanOpenAck, error) {
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(counterpartyVersion), &metadata); err != nil {
+		return MessageOnChanOpenAck{}, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	return MessageOnChanOpenAck{
+		OpenAck: OpenAckDetails{
+			OpenAckDetails: contractmanagertypes.OpenAckDetails{
+				PortID:                portID,
+				ChannelID:             channelID,
+				CounterpartyChannelID: counterpartyChannelID,
+				CounterpartyVersion:   counterpartyVersion,
+			},
+			CounterpartyAddress: metadata.Address,
+			Metadata:            metadata,
+		},
+	}, nil
+}
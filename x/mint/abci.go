@@ -155,6 +155,12 @@ func predictTotalMinted(totalMinted sdk.Uint, normTimePassed, timeAhead sdk.Dec)
 	return fixedAmount.Add(integralAmount)
 }
 
+// PredictMinted returns the amount of tokens that will be minted in the given number of months,
+// starting from the current state of the minter.
+func PredictMinted(minter types.Minter, months uint32) sdk.Uint {
+	return predictTotalMinted(minter.TotalMinted, minter.NormTimePassed, sdk.NewDec(int64(months)))
+}
+
 // BeginBlocker mints new tokens for the previous block.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	minter := k.GetMinter(ctx)
@@ -0,0 +1,20 @@
+package packetforward
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the packetforward module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, packet := range genState.InFlightPackets {
+		k.SetInFlightPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis returns the packetforward module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllInFlightPackets(ctx))
+}
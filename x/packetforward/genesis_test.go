@@ -0,0 +1,107 @@
+package packetforward_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward"
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+)
+
+func newInFlightPacket() types.InFlightPacket {
+	return types.InFlightPacket{
+		SrcPortId:        "transfer",
+		SrcChannelId:     "channel-5",
+		DestPortId:       "transfer",
+		DestChannelId:    "channel-0",
+		Sequence:         3,
+		Data:             []byte(`{"amount":"100"}`),
+		TimeoutTimestamp: 1000,
+		Intermediate:     types.IntermediateAddress("channel-0", "osmo1").String(),
+		Token:            sdk.NewInt64Coin("unls", 100),
+		Receiver:         "juno1",
+		PortId:           "transfer",
+		ChannelId:        "channel-1",
+		Timeout:          time.Minute,
+		RetriesRemaining: 1,
+		ForwardSequence:  7,
+	}
+}
+
+func TestGenesis(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	genesisState := *types.NewGenesisState([]types.InFlightPacket{newInFlightPacket()})
+	require.NoError(t, genesisState.Validate())
+
+	packetforward.InitGenesis(ctx, app.PacketForwardKeeper, genesisState)
+	got := packetforward.ExportGenesis(ctx, app.PacketForwardKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	testCases := []struct {
+		title   string
+		packets func() []types.InFlightPacket
+		valid   bool
+	}{
+		{
+			title:   "default genesis",
+			packets: func() []types.InFlightPacket { return nil },
+			valid:   true,
+		},
+		{
+			title: "duplicate forward",
+			packets: func() []types.InFlightPacket {
+				return []types.InFlightPacket{newInFlightPacket(), newInFlightPacket()}
+			},
+		},
+		{
+			title: "invalid channel",
+			packets: func() []types.InFlightPacket {
+				invalid := newInFlightPacket()
+				invalid.ChannelId = "c"
+				return []types.InFlightPacket{invalid}
+			},
+		},
+		{
+			title: "missing sequence",
+			packets: func() []types.InFlightPacket {
+				invalid := newInFlightPacket()
+				invalid.ForwardSequence = 0
+				return []types.InFlightPacket{invalid}
+			},
+		},
+		{
+			title: "invalid intermediate",
+			packets: func() []types.InFlightPacket {
+				invalid := newInFlightPacket()
+				invalid.Intermediate = "osmo1"
+				return []types.InFlightPacket{invalid}
+			},
+		},
+		{
+			title: "invalid token",
+			packets: func() []types.InFlightPacket {
+				invalid := newInFlightPacket()
+				invalid.Token = sdk.Coin{Denom: "1", Amount: sdk.NewInt(1)}
+				return []types.InFlightPacket{invalid}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := types.NewGenesisState(tc.packets()).Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package packetforward
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/custom/util"
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware forwards the tokens of the incoming ICS-20 transfers whose memo requests it to another chain.
+// The tokens are received by an intermediate account and sent further with the memo's "next" as the memo.
+// The incoming packet is acknowledged once the forward settles: successfully when the next chain accepts
+// the tokens, with an error refunding them to the chain they came from when it rejects them or the forward
+// times out more times than it may be retried.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and the underlying application.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. A forwarded transfer is acknowledged asynchronously,
+// a transfer requesting a forward that cannot be sent is answered with an error acknowledgement.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// leave the rejection of the malformed packets to the transfer application
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	forward, requested, err := types.ParseForward(data.Memo)
+	if !requested {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// the tokens are received by the intermediate account, the memo is not passed to the applications below
+	intermediate := types.IntermediateAddress(packet.GetDestChannel(), data.Sender)
+	received := data
+	received.Receiver = intermediate.String()
+	received.Memo = ""
+	receivedPacket := packet
+	receivedPacket.Data = received.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, receivedPacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	token := sdk.NewCoin(util.ReceivedDenom(packet, data), amount)
+	if err := im.keeper.Forward(ctx, packet, intermediate, token, forward); err != nil {
+		// the tokens received by the intermediate account are reverted along with the error acknowledgement
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. A forward's acknowledgement is passed to
+// the applications below, which refund the intermediate account when it is an error, before the incoming
+// packet is acknowledged.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil
+	}
+
+	return im.keeper.OnForwardAcknowledged(ctx, packet, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. A timed out forward is retried once the applications
+// below refund the intermediate account.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnForwardTimedOut(ctx, packet)
+}
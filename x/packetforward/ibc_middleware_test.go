@@ -0,0 +1,210 @@
+package packetforward_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/stretchr/testify/require"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+)
+
+// setupForwardPaths connects the chain A to the forwarding chain B, and B to the chain C, with transfer channels.
+func setupForwardPaths(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path, *ibctesting.Path) {
+	ibctesting.DefaultTestingAppInit = nolusapp.SetupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 3)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	chainC := coordinator.GetChain(ibctesting.GetChainID(3))
+
+	pathAB := newTransferPath(chainA, chainB)
+	pathBC := newTransferPath(chainB, chainC)
+	coordinator.Setup(pathAB)
+	coordinator.Setup(pathBC)
+
+	return coordinator, pathAB, pathBC
+}
+
+func newTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	return path
+}
+
+func getApp(chain *ibctesting.TestChain) *nolusapp.App {
+	return chain.App.(*nolusapp.App)
+}
+
+func forwardMemo(receiver string, channelID string, timeout string, retries uint32) string {
+	return fmt.Sprintf(`{"forward":{"receiver":"%s","channel":"%s","timeout":"%s","retries":%d}}`, receiver, channelID, timeout, retries)
+}
+
+// transferWithMemo sends the tokens from the sender of chain A through the path to chain B. The transfer is sent
+// by the ibc transfer keeper, as the neutron transfer wrapper serving the transfer messages drops the memo.
+func transferWithMemo(coordinator *ibctesting.Coordinator, path *ibctesting.Path, amount int64, memo string) (channeltypes.Packet, error) {
+	from := path.EndpointA
+	msg := transfertypes.NewMsgTransfer(from.ChannelConfig.PortID, from.ChannelID, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		from.Chain.SenderAccount.GetAddress().String(), "forward", clienttypes.NewHeight(0, 110), 0)
+	msg.Memo = memo
+
+	ctx := from.Chain.GetContext()
+	if _, err := getApp(from.Chain).TransferKeeper.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return channeltypes.Packet{}, err
+	}
+	coordinator.CommitBlock(from.Chain)
+
+	return ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+}
+
+// receiveForwarded receives the packet on chain B and returns the packet forwarding its tokens to chain C.
+func receiveForwarded(t *testing.T, pathAB *ibctesting.Path, packet channeltypes.Packet) channeltypes.Packet {
+	require.NoError(t, pathAB.EndpointB.UpdateClient())
+	res, err := pathAB.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+
+	_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.Error(t, err, "the forwarded packet is acknowledged asynchronously")
+
+	forward, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	return forward
+}
+
+// acknowledge relays the acknowledgement of the incoming packet written by chain B once the forward settles.
+func acknowledge(t *testing.T, pathAB *ibctesting.Path, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	require.NoError(t, pathAB.EndpointA.UpdateClient())
+	require.NoError(t, pathAB.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+}
+
+func voucherOf(paths ...*ibctesting.Path) string {
+	denom := sdk.DefaultBondDenom
+	for _, path := range paths {
+		denom = transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, denom)
+	}
+	return transfertypes.ParseDenomTrace(denom).IBCDenom()
+}
+
+func TestForward(t *testing.T) {
+	coordinator, pathAB, pathBC := setupForwardPaths(t)
+	chainA, chainB, chainC := pathAB.EndpointA.Chain, pathAB.EndpointB.Chain, pathBC.EndpointB.Chain
+	receiver := chainC.SenderAccount.GetAddress()
+
+	packet, err := transferWithMemo(coordinator, pathAB, 100, forwardMemo(receiver.String(), pathBC.EndpointA.ChannelID, "10m", 1))
+	require.NoError(t, err)
+	forward := receiveForwarded(t, pathAB, packet)
+	require.Len(t, getApp(chainB).PacketForwardKeeper.GetAllInFlightPackets(chainB.GetContext()), 1)
+
+	require.NoError(t, pathBC.RelayPacket(forward))
+	acknowledge(t, pathAB, packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+
+	require.Equal(t, sdk.NewInt(100), getApp(chainC).BankKeeper.GetBalance(chainC.GetContext(), receiver, voucherOf(pathAB, pathBC)).Amount)
+	intermediate := types.IntermediateAddress(pathAB.EndpointB.ChannelID, chainA.SenderAccount.GetAddress().String())
+	require.True(t, getApp(chainB).BankKeeper.GetAllBalances(chainB.GetContext(), intermediate).IsZero())
+	require.Empty(t, getApp(chainB).PacketForwardKeeper.GetAllInFlightPackets(chainB.GetContext()))
+}
+
+func TestForwardRefund(t *testing.T) {
+	coordinator, pathAB, pathBC := setupForwardPaths(t)
+	chainA, chainB := pathAB.EndpointA.Chain, pathAB.EndpointB.Chain
+	sender := chainA.SenderAccount.GetAddress()
+	balance := getApp(chainA).BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// rejected by chain C
+	packet, err := transferWithMemo(coordinator, pathAB, 100, forwardMemo("invalid", pathBC.EndpointA.ChannelID, "10m", 1))
+	require.NoError(t, err)
+	forward := receiveForwarded(t, pathAB, packet)
+	require.NoError(t, pathBC.RelayPacket(forward))
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrForwardFailed)
+	acknowledge(t, pathAB, packet, ack)
+
+	require.Equal(t, balance, getApp(chainA).BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom))
+	require.True(t, getApp(chainB).BankKeeper.GetSupply(chainB.GetContext(), voucherOf(pathAB)).IsZero())
+	require.Empty(t, getApp(chainB).PacketForwardKeeper.GetAllInFlightPackets(chainB.GetContext()))
+}
+
+func TestForwardRetriedOnTimeout(t *testing.T) {
+	coordinator, pathAB, pathBC := setupForwardPaths(t)
+	chainB, chainC := pathAB.EndpointB.Chain, pathBC.EndpointB.Chain
+	receiver := chainC.SenderAccount.GetAddress()
+
+	packet, err := transferWithMemo(coordinator, pathAB, 100, forwardMemo(receiver.String(), pathBC.EndpointA.ChannelID, "30s", 1))
+	require.NoError(t, err)
+	forward := receiveForwarded(t, pathAB, packet)
+
+	coordinator.IncrementTimeBy(time.Minute)
+	coordinator.CommitBlock(chainC)
+	retry := timeout(t, pathBC, forward)
+	inFlight := getApp(chainB).PacketForwardKeeper.GetAllInFlightPackets(chainB.GetContext())
+	require.Len(t, inFlight, 1)
+	require.Equal(t, retry.GetSequence(), inFlight[0].ForwardSequence)
+	require.Zero(t, inFlight[0].RetriesRemaining)
+
+	require.NoError(t, pathBC.RelayPacket(retry))
+	acknowledge(t, pathAB, packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	require.Equal(t, sdk.NewInt(100), getApp(chainC).BankKeeper.GetBalance(chainC.GetContext(), receiver, voucherOf(pathAB, pathBC)).Amount)
+}
+
+func TestForwardRefundedOutOfRetries(t *testing.T) {
+	coordinator, pathAB, pathBC := setupForwardPaths(t)
+	chainA, chainB := pathAB.EndpointA.Chain, pathAB.EndpointB.Chain
+	sender := chainA.SenderAccount.GetAddress()
+	balance := getApp(chainA).BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	packet, err := transferWithMemo(coordinator, pathAB, 100, forwardMemo(chainA.SenderAccount.GetAddress().String(), pathBC.EndpointA.ChannelID, "30s", 0))
+	require.NoError(t, err)
+	forward := receiveForwarded(t, pathAB, packet)
+
+	coordinator.IncrementTimeBy(time.Minute)
+	coordinator.CommitBlock(pathBC.EndpointB.Chain)
+	timeout(t, pathBC, forward)
+
+	acknowledge(t, pathAB, packet, channeltypes.NewErrorAcknowledgement(types.ErrForwardFailed))
+	require.Equal(t, balance, getApp(chainA).BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom))
+	require.True(t, getApp(chainB).BankKeeper.GetSupply(chainB.GetContext(), voucherOf(pathAB)).IsZero())
+	require.Empty(t, getApp(chainB).PacketForwardKeeper.GetAllInFlightPackets(chainB.GetContext()))
+}
+
+func TestInvalidForward(t *testing.T) {
+	coordinator, pathAB, _ := setupForwardPaths(t)
+	chainB := pathAB.EndpointB.Chain
+
+	packet, err := transferWithMemo(coordinator, pathAB, 100, `{"forward":{"receiver":"","channel":"channel-0"}}`)
+	require.NoError(t, err)
+	require.NoError(t, pathAB.EndpointB.UpdateClient())
+	res, err := pathAB.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.Contains(t, string(ack), "error")
+	require.True(t, getApp(chainB).BankKeeper.GetSupply(chainB.GetContext(), voucherOf(pathAB)).IsZero())
+}
+
+// timeout times the forward out on chain B and returns the packet retrying it, if any.
+func timeout(t *testing.T, pathBC *ibctesting.Path, packet channeltypes.Packet) channeltypes.Packet {
+	endpoint := pathBC.EndpointA
+	require.NoError(t, endpoint.UpdateClient())
+
+	proof, proofHeight := endpoint.Counterparty.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(
+		endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	require.True(t, found)
+
+	res, err := endpoint.Chain.SendMsgs(channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String()))
+	require.NoError(t, err)
+
+	retry, _ := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	return retry
+}
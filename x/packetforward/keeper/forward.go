@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// Forward sends the tokens received by the intermediate account with an incoming packet further and records
+// the incoming packet to be acknowledged once the forward settles.
+func (k Keeper) Forward(ctx sdk.Context, packet channeltypes.Packet, intermediate sdk.AccAddress, token sdk.Coin, forward types.Forward) error {
+	sequence, err := k.transfer(ctx, intermediate, token, forward.Receiver, forward.Port, forward.Channel, forward.Timeout, forward.Next)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	inFlight := types.NewInFlightPacket(packet, intermediate, token, forward, sequence)
+	k.SetInFlightPacket(ctx, inFlight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyChannel, forward.Channel),
+			sdk.NewAttribute(types.AttributeKeyReceiver, forward.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, token.String()),
+		),
+	)
+
+	return nil
+}
+
+// OnForwardAcknowledged settles the forward sent as the given packet, if any, acknowledging the incoming packet.
+// The tokens of a forward rejected by the next chain, already refunded to the intermediate account, are
+// returned to the chain they came from by an error acknowledgement.
+func (k Keeper) OnForwardAcknowledged(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.RemoveInFlightPacket(ctx, inFlight.PortId, inFlight.ChannelId, inFlight.ForwardSequence)
+
+	if ack.Success() {
+		return k.settle(ctx, inFlight, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	}
+
+	return k.refund(ctx, inFlight, sdkerrors.Wrapf(types.ErrForwardFailed, "rejected by the next chain: %s", ack.GetError()))
+}
+
+// OnForwardTimedOut retries the forward sent as the given timed out packet, if any, or returns the tokens to
+// the chain they came from once it runs out of retries.
+func (k Keeper) OnForwardTimedOut(ctx sdk.Context, packet channeltypes.Packet) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.RemoveInFlightPacket(ctx, inFlight.PortId, inFlight.ChannelId, inFlight.ForwardSequence)
+
+	if inFlight.RetriesRemaining == 0 {
+		return k.refund(ctx, inFlight, sdkerrors.Wrap(types.ErrForwardFailed, "timed out"))
+	}
+
+	intermediate, err := sdk.AccAddressFromBech32(inFlight.Intermediate)
+	if err != nil {
+		return err
+	}
+
+	// a failed retry is final, as the tokens are refunded to the intermediate account by now
+	cacheCtx, writeCache := ctx.CacheContext()
+	sequence, err := k.transfer(cacheCtx, intermediate, inFlight.Token, inFlight.Receiver, inFlight.PortId, inFlight.ChannelId, inFlight.Timeout, inFlight.Next)
+	if err != nil {
+		return k.refund(ctx, inFlight, sdkerrors.Wrapf(types.ErrForwardFailed, "retry failed: %s", err))
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	inFlight.RetriesRemaining--
+	inFlight.ForwardSequence = sequence
+	k.SetInFlightPacket(ctx, inFlight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardRetry,
+			sdk.NewAttribute(types.AttributeKeySrcChannel, inFlight.DestChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(inFlight.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyChannel, inFlight.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyRetries, strconv.FormatUint(uint64(inFlight.RetriesRemaining), 10)),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) transfer(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	token sdk.Coin,
+	receiver, portID, channelID string,
+	timeout time.Duration,
+	memo string,
+) (uint64, error) {
+	msg := transfertypes.NewMsgTransfer(portID, channelID, token, sender.String(), receiver,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(timeout).UnixNano()))
+	msg.Memo = memo
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Sequence, nil
+}
+
+// refund reverts the receipt of the tokens by the intermediate account, as the transfer application would
+// on an error acknowledgement of the incoming packet, and writes that acknowledgement.
+func (k Keeper) refund(ctx sdk.Context, inFlight types.InFlightPacket, reason error) error {
+	intermediate, err := sdk.AccAddressFromBech32(inFlight.Intermediate)
+	if err != nil {
+		return err
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlight.Data, &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+	}
+
+	coins := sdk.NewCoins(inFlight.Token)
+	if transfertypes.ReceiverChainIsSource(inFlight.SrcPortId, inFlight.SrcChannelId, data.Denom) {
+		// the tokens were unescrowed on receipt
+		escrow := transfertypes.GetEscrowAddress(inFlight.DestPortId, inFlight.DestChannelId)
+		if err := k.bankKeeper.SendCoins(ctx, intermediate, escrow, coins); err != nil {
+			return err
+		}
+	} else {
+		// the vouchers were minted on receipt
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediate, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	return k.settle(ctx, inFlight, channeltypes.NewErrorAcknowledgement(reason))
+}
+
+func (k Keeper) settle(ctx sdk.Context, inFlight types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(inFlight.DestPortId, inFlight.DestChannelId))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own the channel capability")
+	}
+
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, inFlight.IncomingPacket(), ack); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardSettled,
+			sdk.NewAttribute(types.AttributeKeySrcChannel, inFlight.DestChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(inFlight.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(ack.Success())),
+			sdk.NewAttribute(types.AttributeKeyError, ack.GetError()),
+		),
+	)
+
+	return nil
+}
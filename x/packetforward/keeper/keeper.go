@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+)
+
+type (
+	Keeper struct {
+		cdc      codec.BinaryCodec
+		storeKey sdk.StoreKey
+
+		transferKeeper types.TransferKeeper
+		bankKeeper     types.BankKeeper
+		ics4Wrapper    porttypes.ICS4Wrapper
+		scopedKeeper   types.ScopedKeeper
+	}
+)
+
+// NewKeeper creates the packetforward keeper. The forwards are sent through the transfer keeper, so they
+// pass the same ICS4Wrapper as any other transfer, and the acknowledgements of the forwarded packets are
+// written with the capabilities of the transfer module.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	transferKeeper types.TransferKeeper,
+	bk types.BankKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	scopedKeeper types.ScopedKeeper,
+) *Keeper {
+	return &Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		transferKeeper: transferKeeper,
+		bankKeeper:     bk,
+		ics4Wrapper:    ics4Wrapper,
+		scopedKeeper:   scopedKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetInFlightPacket stores a forward awaiting its acknowledgement.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.InFlightPacketKey(packet.PortId, packet.ChannelId, packet.ForwardSequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetInFlightPacket returns the forward sent as the given packet.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InFlightPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var packet types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// RemoveInFlightPacket removes a settled forward.
+func (k Keeper) RemoveInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.InFlightPacketKey(portID, channelID, sequence))
+}
+
+// GetAllInFlightPackets returns the forwards awaiting their acknowledgement.
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	packets := []types.InFlightPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
@@ -0,0 +1,13 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/packetforward module sentinel errors.
+var (
+	ErrInvalidForward = sdkerrors.Register(ModuleName, 1, "invalid forward metadata")
+	ErrForwardFailed  = sdkerrors.Register(ModuleName, 2, "packet forward failed")
+)
@@ -0,0 +1,16 @@
+package types
+
+// Packetforward module event types.
+const (
+	EventTypeForward        = "packet_forward"
+	EventTypeForwardRetry   = "packet_forward_retry"
+	EventTypeForwardSettled = "packet_forward_settled"
+
+	AttributeKeySrcChannel = "src_channel"
+	AttributeKeySequence   = "sequence"
+	AttributeKeyChannel    = "forward_channel"
+	AttributeKeyReceiver   = "forward_receiver"
+	AttributeKeyRetries    = "retries_remaining"
+	AttributeKeySuccess    = "success"
+	AttributeKeyError      = "error"
+)
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+const (
+	// DefaultTimeout is the relative timeout of a forward not setting one.
+	DefaultTimeout = 10 * time.Minute
+
+	// DefaultRetries is the number of times a forward not setting them is retried after a timeout.
+	DefaultRetries = 1
+
+	// MaxRetries bounds the number of times a forward is retried after a timeout.
+	MaxRetries = 10
+)
+
+// PacketMetadata is the memo of an ICS-20 transfer to be forwarded by Nolus.
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata tells where to forward the tokens received on Nolus.
+type ForwardMetadata struct {
+	Receiver string `json:"receiver"`
+	Port     string `json:"port,omitempty"`
+	Channel  string `json:"channel"`
+	// Timeout is a duration, e.g. "10m", after which an attempt times out.
+	Timeout string `json:"timeout,omitempty"`
+	// Retries is the number of times the forward is retried after a timeout.
+	Retries *uint32 `json:"retries,omitempty"`
+	// Next is the memo of the forward, a JSON object or string, e.g. forwarding the tokens further.
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// Forward is a validated forward with the defaults applied.
+type Forward struct {
+	Receiver string
+	Port     string
+	Channel  string
+	Timeout  time.Duration
+	Retries  uint32
+	Next     string
+}
+
+// ParseForward returns the forward requested in the memo of a transfer. The memos without a forward,
+// including the ones not being JSON objects, request none.
+func ParseForward(memo string) (Forward, bool, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		return Forward{}, false, nil
+	}
+	if _, ok := raw["forward"]; !ok {
+		return Forward{}, false, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil || metadata.Forward == nil {
+		return Forward{}, true, sdkerrors.Wrapf(ErrInvalidForward, "malformed forward: %s", memo)
+	}
+
+	forward, err := metadata.Forward.toForward()
+	return forward, true, err
+}
+
+func (m ForwardMetadata) toForward() (Forward, error) {
+	forward := Forward{
+		Receiver: m.Receiver,
+		Port:     m.Port,
+		Channel:  m.Channel,
+		Timeout:  DefaultTimeout,
+		Retries:  DefaultRetries,
+	}
+
+	if forward.Receiver == "" {
+		return Forward{}, sdkerrors.Wrap(ErrInvalidForward, "missing receiver")
+	}
+
+	if forward.Port == "" {
+		forward.Port = transfertypes.PortID
+	}
+	if err := host.PortIdentifierValidator(forward.Port); err != nil {
+		return Forward{}, sdkerrors.Wrapf(ErrInvalidForward, "invalid port: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(forward.Channel); err != nil {
+		return Forward{}, sdkerrors.Wrapf(ErrInvalidForward, "invalid channel: %s", err)
+	}
+
+	if m.Timeout != "" {
+		timeout, err := time.ParseDuration(m.Timeout)
+		if err != nil || timeout <= 0 {
+			return Forward{}, sdkerrors.Wrapf(ErrInvalidForward, "invalid timeout: %s", m.Timeout)
+		}
+		forward.Timeout = timeout
+	}
+
+	if m.Retries != nil {
+		if *m.Retries > MaxRetries {
+			return Forward{}, sdkerrors.Wrapf(ErrInvalidForward, "retries exceed the maximum of %d", MaxRetries)
+		}
+		forward.Retries = *m.Retries
+	}
+
+	if len(m.Next) > 0 {
+		var next string
+		if err := json.Unmarshal(m.Next, &next); err != nil {
+			// an object, forwarded as it is
+			next = string(m.Next)
+		}
+		forward.Next = next
+	}
+
+	return forward, nil
+}
+
+// IntermediateAddress returns the account receiving the tokens to be forwarded, derived from the
+// Nolus channel and the sender on the previous chain, so it can hold no one's tokens but theirs.
+func IntermediateAddress(channelID, sender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(ModuleName, []byte(fmt.Sprintf("%s/%s", channelID, sender))))
+}
+
+// NewInFlightPacket records an incoming packet forwarded with the given attempt.
+func NewInFlightPacket(packet channeltypes.Packet, intermediate sdk.AccAddress, token sdk.Coin, forward Forward, forwardSequence uint64) InFlightPacket {
+	return InFlightPacket{
+		SrcPortId:             packet.GetSourcePort(),
+		SrcChannelId:          packet.GetSourceChannel(),
+		DestPortId:            packet.GetDestPort(),
+		DestChannelId:         packet.GetDestChannel(),
+		Sequence:              packet.GetSequence(),
+		Data:                  packet.GetData(),
+		TimeoutRevisionNumber: packet.TimeoutHeight.RevisionNumber,
+		TimeoutRevisionHeight: packet.TimeoutHeight.RevisionHeight,
+		TimeoutTimestamp:      packet.GetTimeoutTimestamp(),
+		Intermediate:          intermediate.String(),
+		Token:                 token,
+		Receiver:              forward.Receiver,
+		PortId:                forward.Port,
+		ChannelId:             forward.Channel,
+		Next:                  forward.Next,
+		Timeout:               forward.Timeout,
+		RetriesRemaining:      forward.Retries,
+		ForwardSequence:       forwardSequence,
+	}
+}
+
+// IncomingPacket returns the incoming packet awaiting the acknowledgement of the forward.
+func (p InFlightPacket) IncomingPacket() channeltypes.Packet {
+	return channeltypes.NewPacket(p.Data, p.Sequence, p.SrcPortId, p.SrcChannelId, p.DestPortId, p.DestChannelId,
+		clienttypes.NewHeight(p.TimeoutRevisionNumber, p.TimeoutRevisionHeight), p.TimeoutTimestamp)
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/x/packetforward/types"
+)
+
+func TestParseForward(t *testing.T) {
+	testCases := []struct {
+		title     string
+		memo      string
+		requested bool
+		expected  types.Forward
+		valid     bool
+	}{
+		{
+			title: "empty memo",
+			memo:  "",
+			valid: true,
+		},
+		{
+			title: "plain text memo",
+			memo:  "thanks",
+			valid: true,
+		},
+		{
+			title: "memo of another middleware",
+			memo:  `{"wasm":{"contract":"nolus1"}}`,
+			valid: true,
+		},
+		{
+			title:     "defaults",
+			memo:      `{"forward":{"receiver":"osmo1","channel":"channel-1"}}`,
+			requested: true,
+			expected:  types.Forward{Receiver: "osmo1", Port: "transfer", Channel: "channel-1", Timeout: types.DefaultTimeout, Retries: types.DefaultRetries},
+			valid:     true,
+		},
+		{
+			title:     "next as object",
+			memo:      `{"forward":{"receiver":"osmo1","port":"transfer","channel":"channel-1","timeout":"1h","retries":0,"next":{"forward":{"receiver":"juno1","channel":"channel-2"}}}}`,
+			requested: true,
+			expected: types.Forward{Receiver: "osmo1", Port: "transfer", Channel: "channel-1", Timeout: time.Hour, Retries: 0,
+				Next: `{"forward":{"receiver":"juno1","channel":"channel-2"}}`},
+			valid: true,
+		},
+		{
+			title:     "next as string",
+			memo:      `{"forward":{"receiver":"osmo1","channel":"channel-1","next":"thanks"}}`,
+			requested: true,
+			expected:  types.Forward{Receiver: "osmo1", Port: "transfer", Channel: "channel-1", Timeout: types.DefaultTimeout, Retries: types.DefaultRetries, Next: "thanks"},
+			valid:     true,
+		},
+		{
+			title:     "malformed forward",
+			memo:      `{"forward":"osmo1"}`,
+			requested: true,
+		},
+		{
+			title:     "missing receiver",
+			memo:      `{"forward":{"channel":"channel-1"}}`,
+			requested: true,
+		},
+		{
+			title:     "invalid channel",
+			memo:      `{"forward":{"receiver":"osmo1","channel":"c"}}`,
+			requested: true,
+		},
+		{
+			title:     "invalid timeout",
+			memo:      `{"forward":{"receiver":"osmo1","channel":"channel-1","timeout":"-1m"}}`,
+			requested: true,
+		},
+		{
+			title:     "too many retries",
+			memo:      `{"forward":{"receiver":"osmo1","channel":"channel-1","retries":11}}`,
+			requested: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			forward, requested, err := types.ParseForward(tc.memo)
+			require.Equal(t, tc.requested, requested)
+			if tc.valid {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, forward)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidForward)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesis returns the default packetforward genesis state without forwards in flight.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]InFlightPacket{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.InFlightPackets))
+	for _, packet := range gs.InFlightPackets {
+		key := string(InFlightPacketKey(packet.PortId, packet.ChannelId, packet.ForwardSequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate forward %d on channel %s", packet.ForwardSequence, packet.ChannelId)
+		}
+		seen[key] = true
+
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the channels, the sequences, the intermediate account and the token of the forward.
+func (p InFlightPacket) Validate() error {
+	for _, channelID := range []string{p.SrcChannelId, p.DestChannelId, p.ChannelId} {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid forward channel: %w", err)
+		}
+	}
+
+	if p.Sequence == 0 || p.ForwardSequence == 0 {
+		return fmt.Errorf("forward %d on channel %s of packet %d: sequences must be positive", p.ForwardSequence, p.ChannelId, p.Sequence)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Intermediate); err != nil {
+		return fmt.Errorf("invalid forward intermediate %s: %w", p.Intermediate, err)
+	}
+
+	if err := p.Token.Validate(); err != nil {
+		return fmt.Errorf("invalid forward token: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "packetforward"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// InFlightPacketPrefix is the store prefix of the forwards awaiting their acknowledgement.
+var InFlightPacketPrefix = []byte{0x01}
+
+// InFlightPacketKey returns the store key of a forward, by the port, channel and sequence of its pending attempt.
+func InFlightPacketKey(portID, channelID string, sequence uint64) []byte {
+	key := append(append([]byte{}, InFlightPacketPrefix...), []byte(portID+"/"+channelID+"/")...)
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
@@ -0,0 +1,1143 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: packetforward/packetforward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket is an incoming ICS-20 packet forwarded to another chain. It is acknowledged once the
+// forward is acknowledged, or refunded once the forward fails.
+type InFlightPacket struct {
+	// src_port_id is the source port of the incoming packet.
+	SrcPortId string `protobuf:"bytes,1,opt,name=src_port_id,json=srcPortId,proto3" json:"src_port_id,omitempty"`
+	// src_channel_id is the source channel of the incoming packet.
+	SrcChannelId string `protobuf:"bytes,2,opt,name=src_channel_id,json=srcChannelId,proto3" json:"src_channel_id,omitempty"`
+	// dest_port_id is the Nolus port of the incoming packet.
+	DestPortId string `protobuf:"bytes,3,opt,name=dest_port_id,json=destPortId,proto3" json:"dest_port_id,omitempty"`
+	// dest_channel_id is the Nolus channel of the incoming packet.
+	DestChannelId string `protobuf:"bytes,4,opt,name=dest_channel_id,json=destChannelId,proto3" json:"dest_channel_id,omitempty"`
+	// sequence is the sequence of the incoming packet.
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// data is the data of the incoming packet.
+	Data                  []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	TimeoutRevisionNumber uint64 `protobuf:"varint,7,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	TimeoutRevisionHeight uint64 `protobuf:"varint,8,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	TimeoutTimestamp      uint64 `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// intermediate is the Nolus account receiving the tokens and sending the forward.
+	Intermediate string `protobuf:"bytes,10,opt,name=intermediate,proto3" json:"intermediate,omitempty"`
+	// token is the forwarded amount in its Nolus denom.
+	Token types.Coin `protobuf:"bytes,11,opt,name=token,proto3" json:"token"`
+	// receiver is the receiver on the next chain.
+	Receiver string `protobuf:"bytes,12,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// port_id is the Nolus port of the forward.
+	PortId string `protobuf:"bytes,13,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the Nolus channel of the forward.
+	ChannelId string `protobuf:"bytes,14,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// next is the memo of the forward, forwarding the tokens further.
+	Next string `protobuf:"bytes,15,opt,name=next,proto3" json:"next,omitempty"`
+	// timeout is the relative timeout of each attempt.
+	Timeout time.Duration `protobuf:"bytes,16,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// retries_remaining is the number of attempts left after a timeout.
+	RetriesRemaining uint32 `protobuf:"varint,17,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	// forward_sequence is the sequence of the pending attempt.
+	ForwardSequence uint64 `protobuf:"varint,18,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ef9b8a582296e8f, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetSrcPortId() string {
+	if m != nil {
+		return m.SrcPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetSrcChannelId() string {
+	if m != nil {
+		return m.SrcChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetDestPortId() string {
+	if m != nil {
+		return m.DestPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetDestChannelId() string {
+	if m != nil {
+		return m.DestChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetTimeoutRevisionNumber() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeoutRevisionHeight() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionHeight
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetIntermediate() string {
+	if m != nil {
+		return m.Intermediate
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types.Coin{}
+}
+
+func (m *InFlightPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "packetforward.InFlightPacket")
+}
+
+func init() { proto.RegisterFile("packetforward/packetforward.proto", fileDescriptor_5ef9b8a582296e8f) }
+
+var fileDescriptor_5ef9b8a582296e8f = []byte{
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6e, 0x7e, 0xbf, 0xee, 0x9f, 0xd7, 0x6e, 0x9d, 0x05, 0x9a, 0x99, 0x44, 0x16, 0x26, 0x84,
+	0x8a, 0x10, 0x89, 0x06, 0x82, 0x03, 0x12, 0x97, 0x0d, 0x21, 0x76, 0x99, 0xaa, 0xb0, 0x13, 0x97,
+	0x28, 0x71, 0xde, 0xa5, 0xd6, 0x1a, 0x3b, 0xd8, 0x4e, 0x19, 0xdf, 0x82, 0x23, 0xdf, 0x88, 0x1d,
+	0x77, 0xe4, 0x04, 0xa8, 0xfd, 0x22, 0xc8, 0x8e, 0x53, 0x5a, 0x69, 0xa7, 0xfa, 0x7d, 0x9e, 0xe7,
+	0x7d, 0x6b, 0x3f, 0xcf, 0x1b, 0xf4, 0xa8, 0x4a, 0xe9, 0x15, 0xe8, 0x4b, 0x21, 0xbf, 0xa4, 0x32,
+	0x8f, 0x56, 0xaa, 0xb0, 0x92, 0x42, 0x0b, 0xdc, 0x5f, 0x01, 0x0f, 0xee, 0x15, 0xa2, 0x10, 0x96,
+	0x89, 0xcc, 0xa9, 0x11, 0x1d, 0xf8, 0x85, 0x10, 0xc5, 0x04, 0x22, 0x5b, 0x65, 0xf5, 0x65, 0x94,
+	0xd7, 0x32, 0xd5, 0x4c, 0xf0, 0x96, 0xa7, 0x42, 0x95, 0x42, 0x45, 0x59, 0xaa, 0x20, 0x9a, 0x1e,
+	0x67, 0xa0, 0xd3, 0xe3, 0x88, 0x0a, 0xe6, 0xf8, 0xa3, 0x1f, 0x6b, 0x68, 0xe7, 0x8c, 0xbf, 0x9f,
+	0xb0, 0x62, 0xac, 0x47, 0xf6, 0xff, 0xb0, 0x8f, 0xb6, 0x95, 0xa4, 0x49, 0x25, 0xa4, 0x4e, 0x58,
+	0x4e, 0xbc, 0xc0, 0x1b, 0x6e, 0xc5, 0x5b, 0x4a, 0xd2, 0x91, 0x90, 0xfa, 0x2c, 0xc7, 0x8f, 0xd1,
+	0x8e, 0xe1, 0xe9, 0x38, 0xe5, 0x1c, 0x26, 0x46, 0xf2, 0x9f, 0x95, 0xf4, 0x94, 0xa4, 0xa7, 0x0d,
+	0x78, 0x96, 0xe3, 0x00, 0xf5, 0x72, 0x50, 0x7a, 0x31, 0xe6, 0x7f, 0xab, 0x41, 0x06, 0x73, 0x73,
+	0x9e, 0xa0, 0x5d, 0xab, 0x58, 0x1a, 0xd4, 0xb5, 0xa2, 0xbe, 0x81, 0xff, 0x4d, 0x3a, 0x40, 0x9b,
+	0x0a, 0x3e, 0xd7, 0xc0, 0x29, 0x90, 0xb5, 0xc0, 0x1b, 0x76, 0xe3, 0x45, 0x8d, 0x31, 0xea, 0xe6,
+	0xa9, 0x4e, 0xc9, 0x7a, 0xe0, 0x0d, 0x7b, 0xb1, 0x3d, 0xe3, 0xd7, 0x68, 0x5f, 0xb3, 0x12, 0x44,
+	0xad, 0x13, 0x09, 0x53, 0xa6, 0x98, 0xe0, 0x09, 0xaf, 0xcb, 0x0c, 0x24, 0xd9, 0xb0, 0xed, 0xf7,
+	0x1d, 0x1d, 0x3b, 0xf6, 0xdc, 0x92, 0x77, 0xf6, 0x8d, 0xc1, 0x18, 0x43, 0x36, 0xef, 0xec, 0xfb,
+	0x60, 0x49, 0xfc, 0x0c, 0xed, 0xb5, 0x7d, 0xe6, 0x57, 0xe9, 0xb4, 0xac, 0xc8, 0x96, 0xed, 0x18,
+	0x38, 0xe2, 0xa2, 0xc5, 0xf1, 0x11, 0xea, 0x31, 0xae, 0x41, 0x96, 0x90, 0xb3, 0x54, 0x03, 0x41,
+	0x8d, 0x75, 0xcb, 0x18, 0x7e, 0x85, 0xd6, 0xb4, 0xb8, 0x02, 0x4e, 0xb6, 0x03, 0x6f, 0xb8, 0xfd,
+	0xe2, 0x41, 0xd8, 0x64, 0x18, 0x9a, 0x0c, 0x43, 0x97, 0x61, 0x78, 0x2a, 0x18, 0x3f, 0xe9, 0xde,
+	0xfc, 0x3a, 0xec, 0xc4, 0x8d, 0xda, 0xf8, 0x24, 0x81, 0x02, 0x9b, 0x82, 0x24, 0x3d, 0x3b, 0x76,
+	0x51, 0xe3, 0x7d, 0xb4, 0xd1, 0x06, 0xd1, 0xb7, 0xd4, 0x7a, 0xd5, 0x84, 0xf0, 0x10, 0xa1, 0x25,
+	0xff, 0x77, 0x9a, 0xac, 0xe9, 0xc2, 0x7b, 0x8c, 0xba, 0x1c, 0xae, 0x35, 0xd9, 0xb5, 0x84, 0x3d,
+	0xe3, 0xb7, 0x68, 0xc3, 0x3d, 0x8b, 0x0c, 0xdc, 0x05, 0x9b, 0x25, 0x0c, 0xdb, 0x25, 0x0c, 0xdf,
+	0xb9, 0x25, 0x3c, 0xd9, 0x34, 0x17, 0xfc, 0xfe, 0xfb, 0xd0, 0x8b, 0xdb, 0x1e, 0x63, 0x97, 0x04,
+	0x2d, 0x19, 0xa8, 0x44, 0x42, 0x99, 0x32, 0xce, 0x78, 0x41, 0xf6, 0x02, 0x6f, 0xd8, 0x8f, 0x07,
+	0x8e, 0x88, 0x5b, 0x1c, 0x3f, 0x45, 0x03, 0xb7, 0xff, 0xc9, 0x62, 0x07, 0xb0, 0xb5, 0x76, 0xd7,
+	0xe1, 0x1f, 0x1d, 0x7c, 0x72, 0x71, 0x33, 0xf3, 0xbd, 0xdb, 0x99, 0xef, 0xfd, 0x99, 0xf9, 0xde,
+	0xb7, 0xb9, 0xdf, 0xb9, 0x9d, 0xfb, 0x9d, 0x9f, 0x73, 0xbf, 0xf3, 0xe9, 0x4d, 0xc1, 0xf4, 0xb8,
+	0xce, 0x42, 0x2a, 0xca, 0xe8, 0x5c, 0x4c, 0x6a, 0xf5, 0x7c, 0x64, 0x2e, 0x4a, 0xc5, 0x24, 0xe2,
+	0xb6, 0xa4, 0x42, 0x42, 0x74, 0xbd, 0xfa, 0x11, 0x46, 0xfa, 0x6b, 0x05, 0x2a, 0x5b, 0xb7, 0x6f,
+	0x7a, 0xf9, 0x77, 0x00, 0x8d, 0xfd, 0x20, 0xdf, 0xb0, 0x03, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardSequence != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPacketforward(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.Next) > 0 {
+		i -= len(m.Next)
+		copy(dAtA[i:], m.Next)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Next)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketforward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Intermediate) > 0 {
+		i -= len(m.Intermediate)
+		copy(dAtA[i:], m.Intermediate)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Intermediate)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.TimeoutRevisionHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.TimeoutRevisionNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DestChannelId) > 0 {
+		i -= len(m.DestChannelId)
+		copy(dAtA[i:], m.DestChannelId)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.DestChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestPortId) > 0 {
+		i -= len(m.DestPortId)
+		copy(dAtA[i:], m.DestPortId)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.DestPortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcChannelId) > 0 {
+		i -= len(m.SrcChannelId)
+		copy(dAtA[i:], m.SrcChannelId)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.SrcChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SrcPortId) > 0 {
+		i -= len(m.SrcPortId)
+		copy(dAtA[i:], m.SrcPortId)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.SrcPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacketforward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketforward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcPortId)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.SrcChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.DestPortId)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.DestChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPacketforward(uint64(m.Sequence))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		n += 1 + sovPacketforward(uint64(m.TimeoutRevisionNumber))
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		n += 1 + sovPacketforward(uint64(m.TimeoutRevisionHeight))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovPacketforward(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Intermediate)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovPacketforward(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.Next)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 2 + l + sovPacketforward(uint64(l))
+	if m.RetriesRemaining != 0 {
+		n += 2 + sovPacketforward(uint64(m.RetriesRemaining))
+	}
+	if m.ForwardSequence != 0 {
+		n += 2 + sovPacketforward(uint64(m.ForwardSequence))
+	}
+	return n
+}
+
+func sovPacketforward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketforward(x uint64) (n int) {
+	return sovPacketforward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketforward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
+			}
+			m.TimeoutRevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
+			}
+			m.TimeoutRevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intermediate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intermediate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Next = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketforward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketforward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketforward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketforward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketforward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketforward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketforward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketforward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketforward = fmt.Errorf("proto: unexpected end of group")
+)
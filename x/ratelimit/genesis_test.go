@@ -0,0 +1,120 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+func TestGenesis(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	flow := types.NewFlow("channel-0", "unls", sdk.NewInt(1000))
+	flow.Buckets = []types.FlowBucket{types.NewFlowBucket(time.Unix(1000, 0).UTC()), types.NewFlowBucket(time.Unix(2000, 0).UTC())}
+	flow.AddInflow(sdk.NewInt(10))
+	flow.AddOutflow(sdk.NewInt(20))
+	genesisState := *types.NewGenesisState(types.NewParams([]types.RateLimit{{
+		ChannelId:  "channel-0",
+		Denom:      "unls",
+		Period:     time.Hour,
+		MaxInflow:  types.NewPercentQuota(sdk.NewDec(5)),
+		MaxOutflow: types.NewAmountQuota(sdk.NewInt(100)),
+	}}), []types.Flow{flow})
+	require.NoError(t, genesisState.Validate())
+
+	ratelimit.InitGenesis(ctx, app.RateLimitKeeper, genesisState)
+	got := ratelimit.ExportGenesis(ctx, app.RateLimitKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	flow := types.NewFlow("channel-0", "unls", sdk.NewInt(1000))
+	flow.Buckets = []types.FlowBucket{types.NewFlowBucket(time.Unix(1000, 0).UTC()), types.NewFlowBucket(time.Unix(2000, 0).UTC())}
+	flow.AddInflow(sdk.NewInt(10))
+
+	testCases := []struct {
+		title string
+		flows func() []types.Flow
+		valid bool
+	}{
+		{
+			title: "default genesis",
+			flows: func() []types.Flow { return nil },
+			valid: true,
+		},
+		{
+			title: "flow",
+			flows: func() []types.Flow { return []types.Flow{flow} },
+			valid: true,
+		},
+		{
+			title: "duplicate flow",
+			flows: func() []types.Flow { return []types.Flow{flow, flow} },
+		},
+		{
+			title: "invalid channel",
+			flows: func() []types.Flow {
+				invalid := flow
+				invalid.ChannelId = "c"
+				return []types.Flow{invalid}
+			},
+		},
+		{
+			title: "invalid denom",
+			flows: func() []types.Flow {
+				invalid := flow
+				invalid.Denom = "1"
+				return []types.Flow{invalid}
+			},
+		},
+		{
+			title: "negative inflow",
+			flows: func() []types.Flow {
+				invalid := flow
+				invalid.Inflow = sdk.NewInt(-1)
+				return []types.Flow{invalid}
+			},
+		},
+		{
+			title: "inflow not the sum of the buckets",
+			flows: func() []types.Flow {
+				invalid := flow
+				invalid.Inflow = sdk.NewInt(11)
+				return []types.Flow{invalid}
+			},
+		},
+		{
+			title: "unordered buckets",
+			flows: func() []types.Flow {
+				invalid := flow
+				invalid.Buckets = []types.FlowBucket{flow.Buckets[1], flow.Buckets[0]}
+				return []types.Flow{invalid}
+			},
+		},
+		{
+			title: "missing outflow",
+			flows: func() []types.Flow {
+				invalid := flow
+				invalid.Outflow = sdk.Int{}
+				return []types.Flow{invalid}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := types.NewGenesisState(types.DefaultParams(), tc.flows()).Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package ratelimit
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/custom/util"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware counts the incoming ICS-20 transfers against the inflow quotas and rejects the ones
+// exceeding them. The outflow quotas are enforced by the keeper wrapping the transfer keeper's ICS4Wrapper,
+// the middleware releases them when an outgoing transfer is refunded.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and the underlying application.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. A transfer exceeding the inflow quota is answered
+// with an error acknowledgement, so the tokens are refunded on the counterparty chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	data, amount, ok := parseTransfer(packet)
+	if !ok {
+		// leave the rejection of the malformed packets to the transfer application
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// the inflow is counted before the tokens are minted or unescrowed, and kept only if they are
+	cacheCtx, writeCache := ctx.CacheContext()
+	denom := util.ReceivedDenom(packet, data)
+	if err := im.keeper.AddInflow(cacheCtx, packet.GetDestChannel(), denom, amount); err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeQuotaExceeded,
+				sdk.NewAttribute(types.AttributeKeyChannel, packet.GetDestChannel()),
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyDirection, types.AttributeValueInflow),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			),
+		)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || ack.Success() {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The outflow quota used by a transfer
+// rejected by the counterparty chain is released once the tokens are refunded.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || ack.Success() {
+		return nil
+	}
+
+	im.undoOutflow(ctx, packet)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The outflow quota used by a timed out transfer
+// is released once the tokens are refunded.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.undoOutflow(ctx, packet)
+	return nil
+}
+
+func (im IBCMiddleware) undoOutflow(ctx sdk.Context, packet channeltypes.Packet) {
+	if data, amount, ok := parseTransfer(packet); ok {
+		im.keeper.UndoOutflow(ctx, packet.GetSourceChannel(), util.SentDenom(data), amount)
+	}
+}
+
+func parseTransfer(packet channeltypes.Packet) (transfertypes.FungibleTokenPacketData, sdk.Int, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return data, sdk.Int{}, false
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	return data, amount, ok
+}
@@ -0,0 +1,166 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/stretchr/testify/require"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+// setupTransferPath connects two Nolus chains with a transfer channel.
+func setupTransferPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	ibctesting.DefaultTestingAppInit = nolusapp.SetupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)))
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	coordinator.Setup(path)
+
+	return coordinator, path
+}
+
+func getApp(chain *ibctesting.TestChain) *nolusapp.App {
+	return chain.App.(*nolusapp.App)
+}
+
+func setRateLimit(chain *ibctesting.TestChain, rateLimit types.RateLimit) {
+	getApp(chain).RateLimitKeeper.SetParams(chain.GetContext(), types.NewParams([]types.RateLimit{rateLimit}))
+}
+
+// transfer sends the tokens from the sender of one end of the path to the sender of the other end.
+func transfer(from, to *ibctesting.Endpoint, amount int64, denom string, timeoutHeight clienttypes.Height) (channeltypes.Packet, error) {
+	return transferTo(from, to.Chain.SenderAccount.GetAddress().String(), amount, denom, timeoutHeight)
+}
+
+func transferTo(from *ibctesting.Endpoint, receiver string, amount int64, denom string, timeoutHeight clienttypes.Height) (channeltypes.Packet, error) {
+	msg := transfertypes.NewMsgTransfer(from.ChannelConfig.PortID, from.ChannelID, sdk.NewInt64Coin(denom, amount),
+		from.Chain.SenderAccount.GetAddress().String(), receiver, timeoutHeight, 0)
+	res, err := from.Chain.SendMsgs(msg)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	return ibctesting.ParsePacketFromEvents(res.GetEvents())
+}
+
+func queryUsage(t *testing.T, chain *ibctesting.TestChain, channelID, denom string) types.RateLimitUsage {
+	res, err := getApp(chain).RateLimitKeeper.RateLimit(sdk.WrapSDKContext(chain.GetContext()),
+		&types.QueryRateLimitRequest{ChannelId: channelID, Denom: denom})
+	require.NoError(t, err)
+	return res.RateLimit
+}
+
+func TestOutflowQuota(t *testing.T) {
+	_, path := setupTransferPath(t)
+	chainA := path.EndpointA.Chain
+	setRateLimit(chainA, types.RateLimit{
+		ChannelId:  path.EndpointA.ChannelID,
+		Denom:      sdk.DefaultBondDenom,
+		Period:     time.Hour,
+		MaxInflow:  types.UnlimitedQuota(),
+		MaxOutflow: types.NewAmountQuota(sdk.NewInt(100)),
+	})
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+
+	packet, err := transfer(path.EndpointA, path.EndpointB, 60, sdk.DefaultBondDenom, timeoutHeight)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	// the testing chains fail the test on a failed transaction, so the exceeding transfer is tried in a discarded context
+	ctx, _ := chainA.GetContext().CacheContext()
+	_, err = getApp(chainA).TransferKeeper.Keeper.Transfer(sdk.WrapSDKContext(ctx), transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 41),
+		chainA.SenderAccount.GetAddress().String(), path.EndpointB.Chain.SenderAccount.GetAddress().String(), timeoutHeight, 0,
+	))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	usage := queryUsage(t, chainA, path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	require.Equal(t, sdk.NewInt(60), usage.Flow.Outflow)
+	require.Equal(t, sdk.NewInt(100), *usage.OutflowLimit)
+	require.Nil(t, usage.InflowLimit)
+
+	// the transfers of other denoms and through other channels are not limited
+	voucher := transfertypes.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	packet, err = transfer(path.EndpointB, path.EndpointA, 1000, sdk.DefaultBondDenom, timeoutHeight)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+	_, err = transfer(path.EndpointA, path.EndpointB, 1000, transfertypes.ParseDenomTrace(voucher).IBCDenom(), timeoutHeight)
+	require.NoError(t, err)
+}
+
+func TestOutflowQuotaReleasedOnRefund(t *testing.T) {
+	coordinator, path := setupTransferPath(t)
+	chainA := path.EndpointA.Chain
+	setRateLimit(chainA, types.RateLimit{
+		ChannelId:  path.EndpointA.ChannelID,
+		Denom:      sdk.DefaultBondDenom,
+		Period:     time.Hour,
+		MaxInflow:  types.UnlimitedQuota(),
+		MaxOutflow: types.NewAmountQuota(sdk.NewInt(100)),
+	})
+
+	// rejected by the counterparty chain
+	packet, err := transferTo(path.EndpointA, "invalid", 100, sdk.DefaultBondDenom, clienttypes.NewHeight(0, 110))
+	require.NoError(t, err)
+	require.True(t, queryUsage(t, chainA, path.EndpointA.ChannelID, sdk.DefaultBondDenom).Flow.Outflow.Equal(sdk.NewInt(100)))
+	require.NoError(t, path.RelayPacket(packet))
+	require.True(t, queryUsage(t, chainA, path.EndpointA.ChannelID, sdk.DefaultBondDenom).Flow.Outflow.IsZero())
+
+	// timed out
+	timeoutHeight := clienttypes.NewHeight(0, uint64(path.EndpointB.Chain.GetContext().BlockHeight())+1)
+	packet, err = transfer(path.EndpointA, path.EndpointB, 100, sdk.DefaultBondDenom, timeoutHeight)
+	require.NoError(t, err)
+	coordinator.CommitNBlocks(path.EndpointB.Chain, 2)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	require.True(t, queryUsage(t, chainA, path.EndpointA.ChannelID, sdk.DefaultBondDenom).Flow.Outflow.IsZero())
+}
+
+func TestInflowQuota(t *testing.T) {
+	_, path := setupTransferPath(t)
+	chainA := path.EndpointA.Chain
+	voucher := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+	setRateLimit(chainA, types.RateLimit{
+		ChannelId:  path.EndpointA.ChannelID,
+		Denom:      voucher,
+		Period:     time.Hour,
+		MaxInflow:  types.NewAmountQuota(sdk.NewInt(100)),
+		MaxOutflow: types.UnlimitedQuota(),
+	})
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	receiver := chainA.SenderAccount.GetAddress()
+	senderB := path.EndpointB.Chain.SenderAccount.GetAddress()
+
+	packet, err := transfer(path.EndpointB, path.EndpointA, 100, sdk.DefaultBondDenom, timeoutHeight)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	balanceB := getApp(path.EndpointB.Chain).BankKeeper.GetBalance(path.EndpointB.Chain.GetContext(), senderB, sdk.DefaultBondDenom)
+	packet, err = transfer(path.EndpointB, path.EndpointA, 1, sdk.DefaultBondDenom, timeoutHeight)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	// the transfer exceeding the quota is refunded
+	require.Equal(t, sdk.NewInt(100), getApp(chainA).BankKeeper.GetBalance(chainA.GetContext(), receiver, voucher).Amount)
+	require.Equal(t, balanceB, getApp(path.EndpointB.Chain).BankKeeper.GetBalance(path.EndpointB.Chain.GetContext(), senderB, sdk.DefaultBondDenom))
+
+	usage := queryUsage(t, chainA, path.EndpointA.ChannelID, voucher)
+	require.Equal(t, sdk.NewInt(100), usage.Flow.Inflow)
+	require.Equal(t, sdk.NewInt(100), *usage.InflowLimit)
+
+	res, err := getApp(chainA).RateLimitKeeper.RateLimits(sdk.WrapSDKContext(chainA.GetContext()), &types.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RateLimitUsage{usage}, res.RateLimits)
+}
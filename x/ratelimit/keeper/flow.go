@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AddInflow counts tokens received through a channel against the inflow quota of their denom.
+// It must be called before the tokens are minted or unescrowed, as the supply may be sampled for a new bucket.
+func (k Keeper) AddInflow(ctx sdk.Context, channelID, denom string, amount sdk.Int) error {
+	rateLimit, found := k.GetParams(ctx).GetRateLimit(channelID, denom)
+	if !found {
+		return nil
+	}
+
+	flow := k.CurrentFlow(ctx, rateLimit, sdk.ZeroInt())
+	flow.AddInflow(amount)
+	if err := checkQuota(rateLimit.MaxInflow, flow, types.AttributeValueInflow, flow.Inflow); err != nil {
+		return err
+	}
+
+	k.SetFlow(ctx, flow)
+	emitFlowEvent(ctx, flow, types.AttributeValueInflow, amount, flow.Inflow)
+	return nil
+}
+
+// AddOutflow counts tokens sent through a channel against the outflow quota of their denom. The burned
+// amount, the vouchers already burned for the transfer, is added back to the supply sampled for a new bucket.
+func (k Keeper) AddOutflow(ctx sdk.Context, channelID, denom string, amount, burned sdk.Int) error {
+	rateLimit, found := k.GetParams(ctx).GetRateLimit(channelID, denom)
+	if !found {
+		return nil
+	}
+
+	flow := k.CurrentFlow(ctx, rateLimit, burned)
+	flow.AddOutflow(amount)
+	if err := checkQuota(rateLimit.MaxOutflow, flow, types.AttributeValueOutflow, flow.Outflow); err != nil {
+		return err
+	}
+
+	k.SetFlow(ctx, flow)
+	emitFlowEvent(ctx, flow, types.AttributeValueOutflow, amount, flow.Outflow)
+	return nil
+}
+
+// UndoOutflow releases the outflow quota used by a transfer refunded after an error acknowledgement or a timeout.
+// The transfers whose usage has left the rolling window release nothing beyond the outflow still in it.
+func (k Keeper) UndoOutflow(ctx sdk.Context, channelID, denom string, amount sdk.Int) {
+	rateLimit, found := k.GetParams(ctx).GetRateLimit(channelID, denom)
+	if !found {
+		return
+	}
+
+	flow, found := k.GetFlow(ctx, channelID, denom)
+	if !found {
+		return
+	}
+
+	flow.Roll(rateLimit.Period, ctx.BlockTime())
+	released := flow.ReleaseOutflow(amount)
+	k.SetFlow(ctx, flow)
+	emitFlowEvent(ctx, flow, types.AttributeValueOutflow, released.Neg(), flow.Outflow)
+}
+
+// CurrentFlow returns the quota usage of a rate limit in the rolling window ending at the current block, without
+// the buckets ended before the window. Once the latest bucket has ended a new one starts, sampling the current
+// supply increased by the given amount.
+func (k Keeper) CurrentFlow(ctx sdk.Context, rateLimit types.RateLimit, supplyAdjustment sdk.Int) types.Flow {
+	flow, found := k.GetFlow(ctx, rateLimit.ChannelId, rateLimit.Denom)
+	if !found {
+		flow = types.NewFlow(rateLimit.ChannelId, rateLimit.Denom, sdk.ZeroInt())
+	}
+
+	flow.Roll(rateLimit.Period, ctx.BlockTime())
+	if !flow.HasOpenBucket(rateLimit.Period, ctx.BlockTime()) {
+		flow.Supply = k.bankKeeper.GetSupply(ctx, rateLimit.Denom).Amount.Add(supplyAdjustment)
+		flow.Buckets = append(flow.Buckets, types.NewFlowBucket(ctx.BlockTime()))
+	}
+
+	return flow
+}
+
+func checkQuota(quota types.Quota, flow types.Flow, direction string, usage sdk.Int) error {
+	if quota.IsUnlimited() {
+		return nil
+	}
+
+	if limit := quota.Limit(flow.Supply); usage.GT(limit) {
+		return sdkerrors.Wrapf(types.ErrQuotaExceeded, "%s of %s on %s would reach %s, the limit is %s",
+			direction, flow.Denom, flow.ChannelId, usage, limit)
+	}
+
+	return nil
+}
+
+func emitFlowEvent(ctx sdk.Context, flow types.Flow, direction string, amount, usage sdk.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFlow,
+			sdk.NewAttribute(types.AttributeKeyChannel, flow.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, flow.Denom),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyUsage, usage.String()),
+		),
+	)
+}
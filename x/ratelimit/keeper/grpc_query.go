@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimits := k.GetParams(ctx).RateLimits
+	usages := make([]types.RateLimitUsage, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		usages = append(usages, k.rateLimitUsage(ctx, rateLimit))
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: usages}, nil
+}
+
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.GetParams(ctx).GetRateLimit(req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s: denom %s on channel %s", types.ErrRateLimitNotFound, req.Denom, req.ChannelId)
+	}
+
+	return &types.QueryRateLimitResponse{RateLimit: k.rateLimitUsage(ctx, rateLimit)}, nil
+}
+
+func (k Keeper) rateLimitUsage(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitUsage {
+	flow := k.CurrentFlow(ctx, rateLimit, sdk.ZeroInt())
+	usage := types.RateLimitUsage{RateLimit: rateLimit, Flow: flow}
+
+	if !rateLimit.MaxInflow.IsUnlimited() {
+		limit := rateLimit.MaxInflow.Limit(flow.Supply)
+		usage.InflowLimit = &limit
+	}
+
+	if !rateLimit.MaxOutflow.IsUnlimited() {
+		limit := rateLimit.MaxOutflow.Limit(flow.Supply)
+		usage.OutflowLimit = &limit
+	}
+
+	return usage
+}
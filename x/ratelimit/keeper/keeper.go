@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+)
+
+type (
+	Keeper struct {
+		cdc        codec.BinaryCodec
+		storeKey   sdk.StoreKey
+		paramstore paramtypes.Subspace
+
+		bankKeeper  types.BankKeeper
+		ics4Wrapper porttypes.ICS4Wrapper
+	}
+)
+
+// NewKeeper creates the ratelimit keeper. It wraps the ICS4Wrapper the transfer keeper sends its packets through,
+// so the outgoing transfers are counted against the outflow quotas.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	ps paramtypes.Subspace,
+	bk types.BankKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper,
+) *Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		cdc:         cdc,
+		storeKey:    storeKey,
+		paramstore:  ps,
+		bankKeeper:  bk,
+		ics4Wrapper: ics4Wrapper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams get all parameters as types.Params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams set the params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
+
+// SetFlow stores the quota usage of a channel and denom.
+func (k Keeper) SetFlow(ctx sdk.Context, flow types.Flow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FlowKey(flow.ChannelId, flow.Denom), k.cdc.MustMarshal(&flow))
+}
+
+// GetFlow returns the stored quota usage of a channel and denom.
+func (k Keeper) GetFlow(ctx sdk.Context, channelID, denom string) (types.Flow, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FlowKey(channelID, denom))
+	if bz == nil {
+		return types.Flow{}, false
+	}
+
+	var flow types.Flow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// GetAllFlows returns the stored quota usages.
+func (k Keeper) GetAllFlows(ctx sdk.Context) []types.Flow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FlowPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	flows := []types.Flow{}
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.Flow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+		flows = append(flows, flow)
+	}
+
+	return flows
+}
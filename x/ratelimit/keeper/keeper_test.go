@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+const (
+	channel = "channel-0"
+	denom   = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+)
+
+type supplyBankKeeper map[string]int64
+
+func (b supplyBankKeeper) GetSupply(_ sdk.Context, denom string) sdk.Coin {
+	return sdk.NewInt64Coin(denom, b[denom])
+}
+
+func rateLimit(maxInflow, maxOutflow types.Quota) types.RateLimit {
+	return types.RateLimit{
+		ChannelId:  channel,
+		Denom:      denom,
+		Period:     time.Hour,
+		MaxInflow:  maxInflow,
+		MaxOutflow: maxOutflow,
+	}
+}
+
+func TestFlows(t *testing.T) {
+	k, ctx := keepertest.RateLimitKeeper(t, supplyBankKeeper{}, nil)
+
+	_, found := k.GetFlow(ctx, channel, denom)
+	require.False(t, found)
+
+	flows := []types.Flow{
+		types.NewFlow(channel, denom, sdk.NewInt(1000)),
+		types.NewFlow(channel, "unls", sdk.NewInt(2000)),
+		types.NewFlow("channel-1", denom, sdk.NewInt(3000)),
+	}
+	flows[0].Buckets = append(flows[0].Buckets, types.NewFlowBucket(time.Unix(100, 0).UTC()))
+	flows[0].AddInflow(sdk.NewInt(10))
+	for _, flow := range flows {
+		k.SetFlow(ctx, flow)
+	}
+
+	got, found := k.GetFlow(ctx, channel, denom)
+	require.True(t, found)
+	require.Equal(t, flows[0], got)
+	require.ElementsMatch(t, flows, k.GetAllFlows(ctx))
+}
+
+func TestAddInflow(t *testing.T) {
+	bank := supplyBankKeeper{denom: 1000}
+	k, ctx := keepertest.RateLimitKeeper(t, bank, nil)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+	k.SetParams(ctx, types.NewParams([]types.RateLimit{
+		rateLimit(types.NewPercentQuota(sdk.NewDec(10)), types.UnlimitedQuota()),
+	}))
+
+	require.NoError(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(60)))
+	require.NoError(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(40)))
+	require.ErrorIs(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(1)), types.ErrQuotaExceeded)
+
+	flow, found := k.GetFlow(ctx, channel, denom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(1000), flow.Supply)
+	require.Equal(t, sdk.NewInt(100), flow.Inflow)
+	require.Len(t, flow.Buckets, 1)
+	require.Equal(t, ctx.BlockTime(), flow.Buckets[0].Start)
+
+	// the unlimited outflow and the other channels are not counted
+	require.NoError(t, k.AddOutflow(ctx, channel, denom, sdk.NewInt(5000), sdk.ZeroInt()))
+	require.NoError(t, k.AddInflow(ctx, "channel-1", denom, sdk.NewInt(5000)))
+	_, found = k.GetFlow(ctx, "channel-1", denom)
+	require.False(t, found)
+
+	// a new bucket samples the supply at its start
+	bank[denom] = 2000
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(6 * time.Minute))
+	require.ErrorIs(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(101)), types.ErrQuotaExceeded)
+	require.NoError(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(100)))
+	require.ErrorIs(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(1)), types.ErrQuotaExceeded)
+
+	flow, _ = k.GetFlow(ctx, channel, denom)
+	require.Equal(t, sdk.NewInt(2000), flow.Supply)
+	require.Equal(t, sdk.NewInt(200), flow.Inflow)
+	require.Len(t, flow.Buckets, 2)
+	require.Equal(t, ctx.BlockTime(), flow.Buckets[1].Start)
+}
+
+func TestRollingWindow(t *testing.T) {
+	k, ctx := keepertest.RateLimitKeeper(t, supplyBankKeeper{}, nil)
+	start := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(start)
+	k.SetParams(ctx, types.NewParams([]types.RateLimit{
+		rateLimit(types.NewAmountQuota(sdk.NewInt(100)), types.UnlimitedQuota()),
+	}))
+
+	require.NoError(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(60)))
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Minute))
+	require.NoError(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(40)))
+
+	// the usage stays in the window for a period after the end of its bucket, unlike a window reset every period
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	require.ErrorIs(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(1)), types.ErrQuotaExceeded)
+	ctx = ctx.WithBlockTime(start.Add(time.Hour + 6*time.Minute - time.Nanosecond))
+	require.ErrorIs(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(1)), types.ErrQuotaExceeded)
+
+	// the first bucket leaves the window while the second one stays
+	ctx = ctx.WithBlockTime(start.Add(time.Hour + 6*time.Minute))
+	require.NoError(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(60)))
+	require.ErrorIs(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(1)), types.ErrQuotaExceeded)
+
+	flow, _ := k.GetFlow(ctx, channel, denom)
+	require.Equal(t, sdk.NewInt(100), flow.Inflow)
+	require.Len(t, flow.Buckets, 2)
+	require.Equal(t, sdk.NewInt(40), flow.Buckets[0].Inflow)
+	require.Equal(t, sdk.NewInt(60), flow.Buckets[1].Inflow)
+
+	// all buckets leave the window once idle for a period
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Hour))
+	require.NoError(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(100)))
+	flow, _ = k.GetFlow(ctx, channel, denom)
+	require.Equal(t, sdk.NewInt(100), flow.Inflow)
+	require.Len(t, flow.Buckets, 1)
+}
+
+func TestAddOutflow(t *testing.T) {
+	bank := supplyBankKeeper{denom: 900}
+	k, ctx := keepertest.RateLimitKeeper(t, bank, nil)
+	k.SetParams(ctx, types.NewParams([]types.RateLimit{
+		rateLimit(types.UnlimitedQuota(), types.NewPercentQuota(sdk.NewDec(50))),
+	}))
+
+	// the burned vouchers are part of the supply of the window
+	require.NoError(t, k.AddOutflow(ctx, channel, denom, sdk.NewInt(100), sdk.NewInt(100)))
+	require.NoError(t, k.AddOutflow(ctx, channel, denom, sdk.NewInt(400), sdk.ZeroInt()))
+	require.ErrorIs(t, k.AddOutflow(ctx, channel, denom, sdk.NewInt(1), sdk.ZeroInt()), types.ErrQuotaExceeded)
+
+	flow, _ := k.GetFlow(ctx, channel, denom)
+	require.Equal(t, sdk.NewInt(1000), flow.Supply)
+	require.Equal(t, sdk.NewInt(500), flow.Outflow)
+	require.True(t, flow.Inflow.IsZero())
+}
+
+func TestAmountQuota(t *testing.T) {
+	k, ctx := keepertest.RateLimitKeeper(t, supplyBankKeeper{}, nil)
+	k.SetParams(ctx, types.NewParams([]types.RateLimit{
+		rateLimit(types.NewAmountQuota(sdk.NewInt(10)), types.NewPercentQuota(sdk.NewDec(100))),
+	}))
+
+	require.NoError(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(10)))
+	require.ErrorIs(t, k.AddInflow(ctx, channel, denom, sdk.NewInt(1)), types.ErrQuotaExceeded)
+	// a percentage of no supply allows nothing
+	require.ErrorIs(t, k.AddOutflow(ctx, channel, denom, sdk.NewInt(1), sdk.ZeroInt()), types.ErrQuotaExceeded)
+}
+
+func TestUndoOutflow(t *testing.T) {
+	k, ctx := keepertest.RateLimitKeeper(t, supplyBankKeeper{}, nil)
+	k.SetParams(ctx, types.NewParams([]types.RateLimit{
+		rateLimit(types.UnlimitedQuota(), types.NewAmountQuota(sdk.NewInt(100))),
+	}))
+
+	require.NoError(t, k.AddOutflow(ctx, channel, denom, sdk.NewInt(100), sdk.ZeroInt()))
+	k.UndoOutflow(ctx, channel, denom, sdk.NewInt(30))
+	flow, _ := k.GetFlow(ctx, channel, denom)
+	require.Equal(t, sdk.NewInt(70), flow.Outflow)
+
+	k.UndoOutflow(ctx, channel, denom, sdk.NewInt(100))
+	flow, _ = k.GetFlow(ctx, channel, denom)
+	require.True(t, flow.Outflow.IsZero())
+
+	// a refund is released from the oldest buckets first
+	require.NoError(t, k.AddOutflow(ctx, channel, denom, sdk.NewInt(50), sdk.ZeroInt()))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, k.AddOutflow(ctx, channel, denom, sdk.NewInt(30), sdk.ZeroInt()))
+	k.UndoOutflow(ctx, channel, denom, sdk.NewInt(20))
+	flow, _ = k.GetFlow(ctx, channel, denom)
+	require.Equal(t, sdk.NewInt(60), flow.Outflow)
+	require.Equal(t, sdk.NewInt(30), flow.Buckets[0].Outflow)
+	require.Equal(t, sdk.NewInt(30), flow.Buckets[1].Outflow)
+
+	// a refund after the usage has left the window releases nothing beyond the outflow still in it
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Minute))
+	k.UndoOutflow(ctx, channel, denom, sdk.NewInt(50))
+	flow, _ = k.GetFlow(ctx, channel, denom)
+	require.True(t, flow.Outflow.IsZero())
+	require.Len(t, flow.Buckets, 1)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// RegisterCodec registers nothing as the rate limits are changed through parameter change proposals.
+func RegisterCodec(cdc *codec.LegacyAmino) {}
+
+// RegisterInterfaces registers nothing as the module has no messages.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
@@ -0,0 +1,13 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/ratelimit module sentinel errors.
+var (
+	ErrQuotaExceeded     = sdkerrors.Register(ModuleName, 1, "transfer quota exceeded")
+	ErrRateLimitNotFound = sdkerrors.Register(ModuleName, 2, "rate limit not found")
+)
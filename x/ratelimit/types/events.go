@@ -0,0 +1,16 @@
+package types
+
+// Ratelimit module event types.
+const (
+	EventTypeFlow          = "rate_limit_flow"
+	EventTypeQuotaExceeded = "rate_limit_quota_exceeded"
+
+	AttributeKeyChannel   = "channel"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyDirection = "direction"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyUsage     = "usage"
+
+	AttributeValueInflow  = "inflow"
+	AttributeValueOutflow = "outflow"
+)
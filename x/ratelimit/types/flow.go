@@ -0,0 +1,93 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BucketsPerPeriod is the number of buckets a rate limit period is counted in.
+const BucketsPerPeriod = 10
+
+// NewFlow creates a quota usage without buckets.
+func NewFlow(channelID, denom string, supply sdk.Int) Flow {
+	return Flow{
+		ChannelId: channelID,
+		Denom:     denom,
+		Supply:    supply,
+		Inflow:    sdk.ZeroInt(),
+		Outflow:   sdk.ZeroInt(),
+	}
+}
+
+// NewFlowBucket creates an empty bucket starting at the given time.
+func NewFlowBucket(start time.Time) FlowBucket {
+	return FlowBucket{
+		Start:   start,
+		Inflow:  sdk.ZeroInt(),
+		Outflow: sdk.ZeroInt(),
+	}
+}
+
+// BucketLength returns the length of the buckets of a period, at least a nanosecond.
+func BucketLength(period time.Duration) time.Duration {
+	if length := period / BucketsPerPeriod; length > 0 {
+		return length
+	}
+
+	return 1
+}
+
+// Roll removes the usage of the buckets ended before the window of the period ending at the given time.
+func (f *Flow) Roll(period time.Duration, now time.Time) {
+	length := BucketLength(period)
+	buckets := make([]FlowBucket, 0, len(f.Buckets))
+	for _, bucket := range f.Buckets {
+		if now.Before(bucket.Start.Add(length).Add(period)) {
+			buckets = append(buckets, bucket)
+			continue
+		}
+
+		f.Inflow = f.Inflow.Sub(bucket.Inflow)
+		f.Outflow = f.Outflow.Sub(bucket.Outflow)
+	}
+	f.Buckets = buckets
+}
+
+// HasOpenBucket reports whether the latest bucket of the period has not ended at the given time.
+func (f Flow) HasOpenBucket(period time.Duration, now time.Time) bool {
+	if len(f.Buckets) == 0 {
+		return false
+	}
+
+	return now.Before(f.Buckets[len(f.Buckets)-1].Start.Add(BucketLength(period)))
+}
+
+// AddInflow counts the amount in the window and its latest bucket, which must exist.
+func (f *Flow) AddInflow(amount sdk.Int) {
+	bucket := &f.Buckets[len(f.Buckets)-1]
+	bucket.Inflow = bucket.Inflow.Add(amount)
+	f.Inflow = f.Inflow.Add(amount)
+}
+
+// AddOutflow counts the amount in the window and its latest bucket, which must exist.
+func (f *Flow) AddOutflow(amount sdk.Int) {
+	bucket := &f.Buckets[len(f.Buckets)-1]
+	bucket.Outflow = bucket.Outflow.Add(amount)
+	f.Outflow = f.Outflow.Add(amount)
+}
+
+// ReleaseOutflow removes up to the amount from the outflow of the window, from the oldest buckets first
+// as the released transfers were sent earlier. It returns the amount removed.
+func (f *Flow) ReleaseOutflow(amount sdk.Int) sdk.Int {
+	released := sdk.ZeroInt()
+	for i := range f.Buckets {
+		bucket := &f.Buckets[i]
+		release := sdk.MinInt(bucket.Outflow, amount.Sub(released))
+		bucket.Outflow = bucket.Outflow.Sub(release)
+		released = released.Add(release)
+	}
+
+	f.Outflow = f.Outflow.Sub(released)
+	return released
+}
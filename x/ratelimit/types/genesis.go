@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(params Params, flows []Flow) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Flows:  flows,
+	}
+}
+
+// DefaultGenesis returns the default ratelimit genesis state without rate limits.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Flow{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Flows))
+	for _, flow := range gs.Flows {
+		key := flow.ChannelId + "/" + flow.Denom
+		if seen[key] {
+			return fmt.Errorf("duplicate flow of denom %s on channel %s", flow.Denom, flow.ChannelId)
+		}
+		seen[key] = true
+
+		if err := flow.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the channel, the denom and the amounts of the flow, which are the sums of its buckets.
+func (f Flow) Validate() error {
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		return fmt.Errorf("invalid flow channel: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return fmt.Errorf("invalid flow denom: %w", err)
+	}
+
+	for _, amount := range []sdk.Int{f.Supply, f.Inflow, f.Outflow} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("invalid amounts in the flow of denom %s on channel %s", f.Denom, f.ChannelId)
+		}
+	}
+
+	inflow, outflow := sdk.ZeroInt(), sdk.ZeroInt()
+	for i, bucket := range f.Buckets {
+		if bucket.Inflow.IsNil() || bucket.Inflow.IsNegative() || bucket.Outflow.IsNil() || bucket.Outflow.IsNegative() {
+			return fmt.Errorf("invalid amounts in a bucket of the flow of denom %s on channel %s", f.Denom, f.ChannelId)
+		}
+
+		if i > 0 && !f.Buckets[i-1].Start.Before(bucket.Start) {
+			return fmt.Errorf("unordered buckets in the flow of denom %s on channel %s", f.Denom, f.ChannelId)
+		}
+
+		inflow = inflow.Add(bucket.Inflow)
+		outflow = outflow.Add(bucket.Outflow)
+	}
+
+	if !inflow.Equal(f.Inflow) || !outflow.Equal(f.Outflow) {
+		return fmt.Errorf("the flow of denom %s on channel %s is not the sum of its buckets", f.Denom, f.ChannelId)
+	}
+
+	return nil
+}
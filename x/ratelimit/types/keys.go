@@ -0,0 +1,23 @@
+package types
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the ratelimit module.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
+
+// FlowPrefix is the store prefix of the quota usages of the rolling windows.
+var FlowPrefix = []byte{0x01}
+
+// FlowKey returns the store key of the quota usage of a channel and denom.
+func FlowKey(channelID, denom string) []byte {
+	return append(append([]byte{}, FlowPrefix...), []byte(channelID+"/"+denom)...)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// The transfers are unlimited by default
+	KeyRateLimits     = []byte("RateLimits")
+	DefaultRateLimits []RateLimit
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the ratelimit module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(rateLimits []RateLimit) Params {
+	return Params{
+		RateLimits: rateLimits,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultRateLimits)
+}
+
+// ParamSetPairs get the params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRateLimits, &p.RateLimits, validateRateLimits),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	return validateRateLimits(p.RateLimits)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// GetRateLimit returns the rate limit of a channel and denom.
+func (p Params) GetRateLimit(channelID, denom string) (RateLimit, bool) {
+	for _, rateLimit := range p.RateLimits {
+		if rateLimit.ChannelId == channelID && rateLimit.Denom == denom {
+			return rateLimit, true
+		}
+	}
+
+	return RateLimit{}, false
+}
+
+func validateRateLimits(v interface{}) error {
+	rateLimits, ok := v.([]RateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := rateLimit.ChannelId + "/" + rateLimit.Denom
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit of denom %s on channel %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Validate checks the channel, the denom, the period and the quotas of the rate limit.
+func (r RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return fmt.Errorf("invalid rate limit channel: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid rate limit denom: %w", err)
+	}
+
+	if r.Period <= 0 {
+		return fmt.Errorf("rate limit period must be positive: %s", r.Period)
+	}
+
+	if err := r.MaxInflow.Validate(); err != nil {
+		return fmt.Errorf("invalid max inflow of denom %s on channel %s: %w", r.Denom, r.ChannelId, err)
+	}
+
+	if err := r.MaxOutflow.Validate(); err != nil {
+		return fmt.Errorf("invalid max outflow of denom %s on channel %s: %w", r.Denom, r.ChannelId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	valid := types.RateLimit{
+		ChannelId:  "channel-0",
+		Denom:      "unls",
+		Period:     24 * time.Hour,
+		MaxInflow:  types.NewPercentQuota(sdk.NewDec(5)),
+		MaxOutflow: types.NewAmountQuota(sdk.NewInt(1_000_000)),
+	}
+
+	tests := []struct {
+		desc   string
+		modify func(*types.RateLimit)
+		valid  bool
+	}{
+		{desc: "valid", modify: func(*types.RateLimit) {}, valid: true},
+		{desc: "unlimited", modify: func(r *types.RateLimit) {
+			r.MaxInflow, r.MaxOutflow = types.UnlimitedQuota(), types.UnlimitedQuota()
+		}, valid: true},
+		{desc: "full supply", modify: func(r *types.RateLimit) { r.MaxInflow = types.NewPercentQuota(sdk.NewDec(100)) }, valid: true},
+		{desc: "invalid channel", modify: func(r *types.RateLimit) { r.ChannelId = "" }},
+		{desc: "invalid denom", modify: func(r *types.RateLimit) { r.Denom = "1" }},
+		{desc: "zero period", modify: func(r *types.RateLimit) { r.Period = 0 }},
+		{desc: "percentage and amount", modify: func(r *types.RateLimit) {
+			r.MaxInflow = types.Quota{Percent: sdk.NewDec(1), Amount: sdk.NewInt(1)}
+		}},
+		{desc: "percentage above 100", modify: func(r *types.RateLimit) { r.MaxInflow = types.NewPercentQuota(sdk.NewDec(101)) }},
+		{desc: "negative percentage", modify: func(r *types.RateLimit) { r.MaxOutflow = types.NewPercentQuota(sdk.NewDec(-1)) }},
+		{desc: "negative amount", modify: func(r *types.RateLimit) { r.MaxOutflow = types.NewAmountQuota(sdk.NewInt(-1)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			rateLimit := valid
+			tt.modify(&rateLimit)
+
+			err := types.NewParams([]types.RateLimit{rateLimit}).Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Error(t, types.NewParams([]types.RateLimit{valid, valid}).Validate())
+	require.NoError(t, types.DefaultParams().Validate())
+}
+
+func TestQuotaLimit(t *testing.T) {
+	require.Equal(t, sdk.NewInt(12), types.NewPercentQuota(sdk.MustNewDecFromStr("1.25")).Limit(sdk.NewInt(999)))
+	require.Equal(t, sdk.NewInt(7), types.NewAmountQuota(sdk.NewInt(7)).Limit(sdk.NewInt(999)))
+	require.True(t, types.UnlimitedQuota().IsUnlimited())
+	require.True(t, types.Quota{}.IsUnlimited())
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var hundred = sdk.NewDec(100)
+
+// NewPercentQuota creates a quota of a percentage of the supply.
+func NewPercentQuota(percent sdk.Dec) Quota {
+	return Quota{Percent: percent, Amount: sdk.ZeroInt()}
+}
+
+// NewAmountQuota creates a quota of an absolute amount.
+func NewAmountQuota(amount sdk.Int) Quota {
+	return Quota{Percent: sdk.ZeroDec(), Amount: amount}
+}
+
+// UnlimitedQuota returns a quota with neither a percentage nor an amount.
+func UnlimitedQuota() Quota {
+	return Quota{Percent: sdk.ZeroDec(), Amount: sdk.ZeroInt()}
+}
+
+// IsUnlimited reports whether neither the percentage nor the amount is set.
+func (q Quota) IsUnlimited() bool {
+	return (q.Percent.IsNil() || q.Percent.IsZero()) && (q.Amount.IsNil() || q.Amount.IsZero())
+}
+
+// Limit returns the amount allowed in a window with the given supply. It is valid only for limited quotas.
+func (q Quota) Limit(supply sdk.Int) sdk.Int {
+	if !q.Amount.IsNil() && q.Amount.IsPositive() {
+		return q.Amount
+	}
+
+	return q.Percent.MulInt(supply).Quo(hundred).TruncateInt()
+}
+
+// Validate checks that at most one of the percentage and the amount is set, and that it is in range.
+func (q Quota) Validate() error {
+	hasPercent := !q.Percent.IsNil() && !q.Percent.IsZero()
+	hasAmount := !q.Amount.IsNil() && !q.Amount.IsZero()
+
+	if hasPercent && hasAmount {
+		return fmt.Errorf("quota sets both a percentage and an amount")
+	}
+
+	if hasPercent && (q.Percent.IsNegative() || q.Percent.GT(hundred)) {
+		return fmt.Errorf("quota percentage must be between 0 and 100: %s", q.Percent)
+	}
+
+	if hasAmount && q.Amount.IsNegative() {
+		return fmt.Errorf("quota amount must not be negative: %s", q.Amount)
+	}
+
+	return nil
+}
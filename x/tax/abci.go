@@ -0,0 +1,139 @@
+package tax
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BeginBlocker settles the fees kept before the block, i.e. by the genesis transactions.
+// Their gas is consumed by the genesis block gas meter, so they are taxed on the whole fee.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper) {
+	settleTxFees(ctx, k, bk, func(txFee types.TxFee, _ *types.TxFee) uint64 {
+		return txFee.GasLimit
+	})
+}
+
+// EndBlocker refunds the fee of the unused gas, sends the tax on the fee consumed during the block
+// from the fee collector to the treasury and updates the base fee for the following block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	// The block gas meter is charged with the gas of every delivered tx once the tx completes,
+	// so the gas used by a tx is the block gas consumed until the fee of the next one is kept.
+	// The gas of a tx rejected by the ante handler after its fee is kept is counted toward the previous tx,
+	// which can only lower the refund of the latter, since the gas used never exceeds the gas limit.
+	blockGasUsed := ctx.BlockGasMeter().GasConsumed()
+	settleTxFees(ctx, k, bk, func(txFee types.TxFee, next *types.TxFee) uint64 {
+		if next == nil {
+			return txFee.GasUsed(blockGasUsed)
+		}
+		return txFee.GasUsed(next.BlockGasUsed)
+	})
+	updateBaseFee(ctx, k)
+}
+
+// settleTxFees refunds the fee of the unused gas of the kept fees and sends the tax on the rest to the treasury.
+func settleTxFees(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper, gasUsed func(txFee types.TxFee, next *types.TxFee) uint64) {
+	var txFees []types.TxFee
+	k.IterateTxFees(ctx, func(txFee types.TxFee) bool {
+		txFees = append(txFees, txFee)
+		return false
+	})
+	if len(txFees) == 0 {
+		return
+	}
+	k.ClearTxFees(ctx)
+
+	refundRate := k.UnusedGasRefundRate(ctx)
+	collected := sdk.NewCoins()
+	for i, txFee := range txFees {
+		if txFee.Fee.IsZero() {
+			continue
+		}
+
+		var next *types.TxFee
+		if i+1 < len(txFees) {
+			next = &txFees[i+1]
+		}
+
+		consumed := txFee.Fee
+		if refundRate.IsPositive() {
+			txGasUsed := gasUsed(txFee, next)
+			if refund := txFee.UnusedGasRefund(txGasUsed, refundRate); refund.IsPositive() && refundUnusedGas(ctx, k, bk, txFee, refund, txGasUsed) {
+				// the refunded part of the fee is not taxed
+				consumed = consumed.Sub(refund)
+			}
+		}
+
+		collected = collected.Add(k.Tax(ctx, consumed))
+	}
+
+	settleCollectedTax(ctx, k, bk, collected)
+}
+
+func refundUnusedGas(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper, txFee types.TxFee, refund sdk.Coin, gasUsed uint64) bool {
+	payer, err := sdk.AccAddressFromBech32(txFee.Payer)
+	if err != nil {
+		panic(err)
+	}
+
+	if err = bk.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, payer, sdk.NewCoins(refund)); err != nil {
+		k.Logger(ctx).Error("failed to refund the unused gas fee", "payer", txFee.Payer, "refund", refund, "err", err)
+		return false
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundFee,
+			sdk.NewAttribute(types.AttributeKeyPayer, txFee.Payer),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprint(gasUsed)),
+		),
+	)
+
+	return true
+}
+
+func settleCollectedTax(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper, collected sdk.Coins) {
+	if collected.Empty() {
+		return
+	}
+
+	treasuryAddr, err := sdk.AccAddressFromBech32(k.ContractAddress(ctx))
+	if err != nil {
+		panic(err)
+	}
+
+	// the fees, including the tax, are deducted to the fee collector by the ante handler
+	// of the very same transactions, so it always holds at least the collected amount
+	if err = bk.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasuryAddr, collected); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleTax,
+			sdk.NewAttribute(types.AttributeKeyTreasury, treasuryAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collected.String()),
+		),
+	)
+}
+
+func updateBaseFee(ctx sdk.Context, k keeper.Keeper) {
+	gasUsed := ctx.BlockGasMeter().GasConsumed()
+	baseFee := k.UpdateBaseFee(ctx, gasUsed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprint(gasUsed)),
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+		),
+	)
+}
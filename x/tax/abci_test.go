@@ -0,0 +1,127 @@
+package tax_test
+
+import (
+	"math/rand"
+	"testing"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestUnusedGasRefund(t *testing.T) {
+	const (
+		gasLimit = 200000
+		feeAmt   = 1000
+		balance  = 1_000_000
+	)
+
+	refundRate := sdk.MustNewDecFromStr("0.5")
+
+	// the refund is the refund rate of the fee of the unused gas, and the tax is the fee rate of the rest,
+	// both truncated, e.g. 1000 * (200000 - 111276) / 200000 * 0.5 = 221 and (1000 - 221) * 40% = 311
+	testCases := []struct {
+		title        string
+		sendAmt      int64
+		gasLimit     uint64
+		expCode      uint32
+		expGasUsed   int64
+		expBalance   int64
+		expTax       int64
+		expCollector int64
+	}{
+		{
+			title:        "successful tx is refunded the fee of the unused gas",
+			sendAmt:      100,
+			gasLimit:     gasLimit,
+			expGasUsed:   111276,
+			expBalance:   balance - 100 - feeAmt + 221,
+			expTax:       311,
+			expCollector: 468,
+		},
+		{
+			title:        "failed tx is refunded the fee of the unused gas",
+			sendAmt:      2 * balance,
+			gasLimit:     gasLimit,
+			expCode:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+			expGasUsed:   93962,
+			expBalance:   balance - feeAmt + 265,
+			expTax:       294,
+			expCollector: 441,
+		},
+		{
+			title:        "out of gas tx is not refunded",
+			sendAmt:      100,
+			gasLimit:     90000,
+			expCode:      sdkerrors.ErrOutOfGas.ABCICode(),
+			expGasUsed:   90265,
+			expBalance:   balance - feeAmt,
+			expTax:       400,
+			expCollector: 600,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			priv, _, addr := testdata.KeyTestPubAddr()
+			_, _, recipient := testdata.KeyTestPubAddr()
+
+			denom := types.DefaultBaseDenom
+			acc := authtypes.NewBaseAccount(addr, priv.PubKey(), 0, 0)
+			app := nolusapp.SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{acc}, banktypes.Balance{
+				Address: addr.String(),
+				Coins:   sdk.NewCoins(sdk.NewInt64Coin(denom, balance)),
+			})
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			ctx := app.BaseApp.NewContext(false, header)
+			params := app.TaxKeeper.GetParams(ctx)
+			params.UnusedGasRefundRate = refundRate
+			params.FeeRate = 40
+			app.TaxKeeper.SetParams(ctx, params)
+			treasury := sdk.MustAccAddressFromBech32(params.ContractAddress)
+			accNum := app.AccountKeeper.GetAccount(ctx, addr).GetAccountNumber()
+
+			encodingConfig := simapp.MakeTestEncodingConfig()
+			tx, err := helpers.GenSignedMockTx(
+				rand.New(rand.NewSource(1)),
+				encodingConfig.TxConfig,
+				[]sdk.Msg{banktypes.NewMsgSend(addr, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, tc.sendAmt)))},
+				sdk.NewCoins(sdk.NewInt64Coin(denom, feeAmt)),
+				tc.gasLimit,
+				"",
+				[]uint64{accNum},
+				[]uint64{0},
+				priv,
+			)
+			require.NoError(t, err)
+			txBytes, err := encodingConfig.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			require.Equal(t, tc.expCode, res.Code, res.Log)
+
+			app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+			app.Commit()
+
+			ctx = app.BaseApp.NewContext(true, header)
+
+			require.Equal(t, tc.expGasUsed, res.GasUsed)
+			feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			require.Equal(t, sdk.NewInt64Coin(denom, tc.expBalance), app.BankKeeper.GetBalance(ctx, addr, denom))
+			require.Equal(t, sdk.NewInt64Coin(denom, tc.expTax), app.BankKeeper.GetBalance(ctx, treasury, denom))
+			require.Equal(t, sdk.NewInt64Coin(denom, tc.expCollector), app.BankKeeper.GetBalance(ctx, feeCollector, denom))
+		})
+	}
+}
@@ -25,6 +25,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryMinGasPrices())
+	cmd.AddCommand(CmdQueryBaseFee())
+	cmd.AddCommand(CmdQueryDisallowedMsgTypes())
 
 	return cmd
 }
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryDisallowedMsgTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disallowed-msg-types",
+		Short: "shows the type URLs of the messages rejected by the network",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DisallowedMsgTypes(context.Background(), &types.QueryDisallowedMsgTypesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -10,12 +10,19 @@ import (
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+
+	baseFee := genState.BaseFee
+	if baseFee.IsNil() {
+		baseFee = genState.Params.MinGasPrice
+	}
+	k.SetBaseFee(ctx, genState.Params.BoundBaseFee(baseFee))
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.BaseFee = k.GetBaseFee(ctx)
 
 	return genesis
 }
@@ -0,0 +1,61 @@
+package tax_test
+
+import (
+	"testing"
+
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tax"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// recvApp records the packets passed through by the middleware.
+type recvApp struct {
+	porttypes.IBCModule
+
+	received int
+}
+
+func (a *recvApp) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	a.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestIBCMiddlewareOnRecvPacket(t *testing.T) {
+	k, ctx := keepertest.TaxKeeper(t)
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	app := &recvApp{}
+	middleware := tax.NewIBCMiddleware(app, *k, cdc)
+
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	send := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("unls", 1)))
+	txData, err := icatypes.SerializeCosmosTx(cdc, []sdk.Msg{send})
+	require.NoError(t, err)
+	packet := channeltypes.Packet{Data: icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: txData}.GetBytes()}
+
+	ack := middleware.OnRecvPacket(ctx, packet, nil)
+	require.True(t, ack.Success())
+	require.Equal(t, 1, app.received)
+
+	params := k.GetParams(ctx)
+	params.DisallowedMsgTypes = []string{sdk.MsgTypeURL(send)}
+	k.SetParams(ctx, params)
+
+	// the disallowed messages are not executed even if the host allows them
+	ack = middleware.OnRecvPacket(ctx, packet, nil)
+	require.False(t, ack.Success())
+	require.Equal(t, 1, app.received)
+
+	// the malformed packets are left to the host
+	ack = middleware.OnRecvPacket(ctx, channeltypes.Packet{Data: []byte("malformed")}, nil)
+	require.True(t, ack.Success())
+	require.Equal(t, 2, app.received)
+}
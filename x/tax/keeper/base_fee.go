@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBaseFee returns the base fee in force, kept within the bounds of the current params.
+// It falls back to the minimum gas price if the base fee has not been set yet.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeKey)
+	if bz == nil {
+		return params.BoundBaseFee(params.MinGasPrice)
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return params.BoundBaseFee(baseFee)
+}
+
+// SetBaseFee sets the base fee in force.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, bz)
+}
+
+// GetBlockGasUsed returns the gas used by the last block.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockGasUsedKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetBlockGasUsed sets the gas used by the last block.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gasUsed uint64) {
+	ctx.KVStore(k.storeKey).Set(types.BlockGasUsedKey, sdk.Uint64ToBigEndian(gasUsed))
+}
+
+// UpdateBaseFee sets the base fee of the following block from the gas used by the current one.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) sdk.Dec {
+	baseFee := k.GetParams(ctx).NextBaseFee(k.GetBaseFee(ctx), gasUsed)
+
+	k.SetBaseFee(ctx, baseFee)
+	k.SetBlockGasUsed(ctx, gasUsed)
+
+	return baseFee
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBaseFee(t *testing.T) {
+	k, ctx := testkeeper.TaxKeeper(t)
+	params := types.DefaultParams()
+	k.SetParams(ctx, params)
+
+	// an unset base fee falls back to the minimum gas price
+	require.Equal(t, params.MinGasPrice, k.GetBaseFee(ctx))
+
+	k.SetBaseFee(ctx, sdk.MustNewDecFromStr("0.01"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), k.GetBaseFee(ctx))
+
+	// a stored base fee out of the bounds of the current params is clamped
+	k.SetBaseFee(ctx, params.MaxBaseFee.MulInt64(2))
+	require.Equal(t, params.MaxBaseFee, k.GetBaseFee(ctx))
+}
+
+func TestUpdateBaseFee(t *testing.T) {
+	k, ctx := testkeeper.TaxKeeper(t)
+	params := types.DefaultParams()
+	params.TargetBlockGas = 1_000_000
+	k.SetParams(ctx, params)
+
+	// full blocks raise the base fee by 1/8 each
+	baseFee := k.UpdateBaseFee(ctx, 2_000_000)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0028125"), baseFee)
+	require.Equal(t, baseFee, k.GetBaseFee(ctx))
+	require.Equal(t, uint64(2_000_000), k.GetBlockGasUsed(ctx))
+
+	// empty blocks lower it back to the minimum gas price
+	for i := 0; i < 10; i++ {
+		baseFee = k.UpdateBaseFee(ctx, 0)
+	}
+	require.Equal(t, params.MinGasPrice, baseFee)
+	require.Equal(t, uint64(0), k.GetBlockGasUsed(ctx))
+}
+
+func TestMinGasPricesFollowBaseFee(t *testing.T) {
+	k, ctx := testkeeper.TaxKeeper(t)
+	params := types.DefaultParams()
+	params.FeeDenomMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0001")))
+	k.SetParams(ctx, params)
+
+	k.SetBaseFee(ctx, params.MinGasPrice.MulInt64(3))
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(params.BaseDenom, params.MinGasPrice.MulInt64(3)),
+		sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0003")),
+	), k.GetMinGasPrices(ctx))
+}
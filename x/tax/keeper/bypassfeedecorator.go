@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/custom/util"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BypassFeeDecorator skips the wrapped minimum fee decorators for the transactions containing only
+// messages of the governance approved bypass types, typically the IBC relayer ones, within the gas cap.
+// Such transactions may be free of charge. The ones carrying a fee still pay it and the tax on it.
+// The redundant relay protection of the IBC ante decorator keeps rejecting the free txs relaying nothing new.
+// Call the wrapped decorators unless the tx is exempt, then call next AnteHandler
+// CONTRACT: Tx must implement FeeTx interface to use BypassFeeDecorator.
+type BypassFeeDecorator struct {
+	tk            Keeper
+	feeDecorators []sdk.AnteDecorator
+}
+
+func NewBypassFeeDecorator(tk Keeper, feeDecorators ...sdk.AnteDecorator) BypassFeeDecorator {
+	return BypassFeeDecorator{
+		tk:            tk,
+		feeDecorators: feeDecorators,
+	}
+}
+
+func (bfd BypassFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if bfd.isMinFeeBypassed(ctx, tx.GetMsgs(), feeTx.GetGas()) {
+		return next(ctx, tx, simulate)
+	}
+
+	return util.ChainWrappedAnteDecorators(next, bfd.feeDecorators...)(ctx, tx, simulate)
+}
+
+// isMinFeeBypassed returns whether a tx with the given messages and gas limit is exempt from the minimum fee.
+// All of its messages must be of a bypass type and the gas limit must not exceed the cap.
+func (bfd BypassFeeDecorator) isMinFeeBypassed(ctx sdk.Context, msgs []sdk.Msg, gasLimit uint64) bool {
+	if len(msgs) == 0 || gasLimit > bfd.tk.MaxBypassMinFeeMsgGasUsage(ctx) {
+		return false
+	}
+
+	bypassMsgTypes := bfd.tk.BypassMinFeeMsgTypes(ctx)
+	for _, msg := range msgs {
+		if !types.ContainsMsgType(bypassMsgTypes, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+
+	return true
+}
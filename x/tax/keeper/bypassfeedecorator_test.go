@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+func (suite *KeeperTestSuite) TestBypassFeeDecorator() {
+	suite.SetupTest(true)
+
+	baseDenom := suite.app.TaxKeeper.BaseDenom(suite.ctx)
+	_, _, from := sdktestutil.KeyTestPubAddr()
+	_, _, to := sdktestutil.KeyTestPubAddr()
+	send := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1)))
+	updateClient := &ibcclienttypes.MsgUpdateClient{Signer: from.String()}
+	recvPacket := &ibcchanneltypes.MsgRecvPacket{Signer: from.String()}
+
+	testCases := []struct {
+		title    string
+		msgs     []sdk.Msg
+		gasLimit uint64
+		fees     sdk.Coins
+		expPass  bool
+	}{
+		{
+			title:    "free tx with relayer messages only should pass",
+			msgs:     []sdk.Msg{updateClient, recvPacket},
+			gasLimit: types.DefaultMaxBypassMinFeeMsgGasUsage,
+			expPass:  true,
+		},
+		{
+			title:    "free tx with relayer messages above the gas cap should fail",
+			msgs:     []sdk.Msg{updateClient, recvPacket},
+			gasLimit: types.DefaultMaxBypassMinFeeMsgGasUsage + 1,
+			expPass:  false,
+		},
+		{
+			title:    "free tx with relayer and other messages should fail",
+			msgs:     []sdk.Msg{updateClient, send},
+			gasLimit: 200000,
+			expPass:  false,
+		},
+		{
+			title:    "free tx with other messages should fail",
+			msgs:     []sdk.Msg{send},
+			gasLimit: 200000,
+			expPass:  false,
+		},
+		{
+			title:    "tx with other messages covering the minimum gas price should pass",
+			msgs:     []sdk.Msg{send},
+			gasLimit: 200000,
+			fees:     sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 500)),
+			expPass:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest(true)
+
+		suite.Run(tc.title, func() {
+			suite.Require().NoError(suite.txBuilder.SetMsgs(tc.msgs...))
+			suite.txBuilder.SetGasLimit(tc.gasLimit)
+			suite.txBuilder.SetFeeAmount(tc.fees)
+
+			anteHandler := sdk.ChainAnteDecorators(keeper.NewBypassFeeDecorator(suite.app.TaxKeeper, keeper.NewMinGasPriceDecorator(suite.app.TaxKeeper)))
+			_, err := anteHandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee, tc.title)
+				return
+			}
+
+			suite.Require().NoError(err, tc.title)
+		})
+	}
+}
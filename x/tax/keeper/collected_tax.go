@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var HUNDRED_DEC = sdk.NewDec(100)
+
+// Tax returns the tax on the given fee by the fee rate in force.
+func (k Keeper) Tax(ctx sdk.Context, fee sdk.Coin) sdk.Coin {
+	feeRate := sdk.NewDec(int64(k.FeeRate(ctx)))
+
+	// There are cases where the tax calculation could result in a number between 0 and 1.
+	// In those cases, the tax will be 0, since the lowest registered unit we have is 1unls
+	return sdk.NewCoin(fee.Denom, feeRate.MulInt(fee.Amount).Quo(HUNDRED_DEC).TruncateInt())
+}
+
+// GetCollectedTax returns the tax on the fees paid in the current block.
+// The tax is settled at the end of the block on the fee actually consumed,
+// so it is lower if a part of the fee is refunded for the unused gas.
+func (k Keeper) GetCollectedTax(ctx sdk.Context) sdk.Coins {
+	collected := sdk.NewCoins()
+	k.IterateTxFees(ctx, func(txFee types.TxFee) bool {
+		collected = collected.Add(k.Tax(ctx, txFee.Fee))
+		return false
+	})
+
+	return collected
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	baseFee := k.GetBaseFee(ctx)
+	nextBaseFee := k.GetParams(ctx).NextBaseFee(baseFee, k.GetBlockGasUsed(ctx))
+
+	return &types.QueryBaseFeeResponse{BaseFee: baseFee, NextBaseFee: nextBaseFee}, nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DisallowedMsgTypes(c context.Context, req *types.QueryDisallowedMsgTypesRequest) (*types.QueryDisallowedMsgTypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDisallowedMsgTypesResponse{MsgTypeUrls: k.GetDisallowedMsgTypes(ctx)}, nil
+}
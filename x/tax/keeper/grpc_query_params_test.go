@@ -30,3 +30,59 @@ func TestParamsQueryNilRequest(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, response)
 }
+
+func TestMinGasPricesQuery(t *testing.T) {
+	keeper, ctx := testkeeper.TaxKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	params := types.DefaultParams()
+	params.FeeDenomMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0001")))
+	keeper.SetParams(ctx, params)
+
+	response, err := keeper.MinGasPrices(wctx, &types.QueryMinGasPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(params.BaseDenom, params.MinGasPrice),
+		sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0001")),
+	), response.MinGasPrices)
+
+	response, err = keeper.MinGasPrices(wctx, nil)
+	require.Error(t, err)
+	require.Nil(t, response)
+}
+
+func TestBaseFeeQuery(t *testing.T) {
+	keeper, ctx := testkeeper.TaxKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	params := types.DefaultParams()
+	params.TargetBlockGas = 1_000_000
+	keeper.SetParams(ctx, params)
+	keeper.SetBaseFee(ctx, sdk.MustNewDecFromStr("0.004"))
+	keeper.SetBlockGasUsed(ctx, 2_000_000)
+
+	response, err := keeper.BaseFee(wctx, &types.QueryBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBaseFeeResponse{
+		BaseFee:     sdk.MustNewDecFromStr("0.004"),
+		NextBaseFee: sdk.MustNewDecFromStr("0.0045"),
+	}, response)
+
+	response, err = keeper.BaseFee(wctx, nil)
+	require.Error(t, err)
+	require.Nil(t, response)
+}
+
+func TestDisallowedMsgTypesQuery(t *testing.T) {
+	keeper, ctx := testkeeper.TaxKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	params := types.DefaultParams()
+	params.DisallowedMsgTypes = []string{"/cosmwasm.wasm.v1.MsgStoreCode"}
+	keeper.SetParams(ctx, params)
+
+	response, err := keeper.DisallowedMsgTypes(wctx, &types.QueryDisallowedMsgTypesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryDisallowedMsgTypesResponse{MsgTypeUrls: params.DisallowedMsgTypes}, response)
+
+	response, err = keeper.DisallowedMsgTypes(wctx, nil)
+	require.Error(t, err)
+	require.Nil(t, response)
+}
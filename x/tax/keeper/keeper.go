@@ -13,17 +13,20 @@ import (
 
 type (
 	Keeper struct {
-		cdc        codec.BinaryCodec
-		storeKey   sdk.StoreKey
-		memKey     sdk.StoreKey
-		paramstore paramtypes.Subspace
+		cdc             codec.BinaryCodec
+		storeKey        sdk.StoreKey
+		memKey          sdk.StoreKey
+		tStoreKey       sdk.StoreKey
+		paramstore      paramtypes.Subspace
+		priorityTracker *txPriorityTracker
 	}
 )
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey,
-	memKey sdk.StoreKey,
+	memKey,
+	tStoreKey sdk.StoreKey,
 	ps paramtypes.Subspace,
 ) *Keeper {
 	// set KeyTable if it has not already been set
@@ -32,10 +35,12 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramstore: ps,
+		cdc:             cdc,
+		storeKey:        storeKey,
+		memKey:          memKey,
+		tStoreKey:       tStoreKey,
+		paramstore:      ps,
+		priorityTracker: &txPriorityTracker{priority: make(map[string]int64)},
 	}
 }
 
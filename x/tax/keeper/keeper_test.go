@@ -42,7 +42,7 @@ type KeeperTestSuite struct {
 func (s *KeeperTestSuite) SetupTest(isCheckTx bool) {
 	tempDir := s.T().TempDir()
 	s.app, s.ctx = nolusapp.CreateTestApp(isCheckTx, tempDir)
-	s.ctx = s.ctx.WithBlockHeight(1)
+	s.ctx = s.ctx.WithBlockHeight(1).WithBlockGasMeter(sdk.NewInfiniteGasMeter())
 
 	// set up TxConfig
 	encodingConfig := simapp.MakeTestEncodingConfig()
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"reflect"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It sets the parameters introduced in version 3 to their default values
+// and keeps the ones that are already set.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if m.keeper.paramstore.Has(ctx, pair.Key) {
+			continue
+		}
+
+		m.keeper.paramstore.Set(ctx, pair.Key, reflect.ValueOf(pair.Value).Elem().Interface())
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MinGasPriceDecorator rejects transactions whose gas price is below the network minimum gas price.
+// Unlike the validator's local min-gas-prices, the minimum is set by the module and it is enforced
+// in both CheckTx and DeliverTx. Fees can be paid in the base denom, at no less than the current
+// base fee, or in any of the governance approved fee denoms, each one having a separate floor.
+// Call next AnteHandler if the fee covers the minimum gas price or the tx is simulated
+// CONTRACT: Tx must implement FeeTx interface to use MinGasPriceDecorator.
+type MinGasPriceDecorator struct {
+	tk Keeper
+}
+
+func NewMinGasPriceDecorator(tk Keeper) MinGasPriceDecorator {
+	return MinGasPriceDecorator{
+		tk: tk,
+	}
+}
+
+func (mgpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// Simulations estimate the gas and the genesis transactions are free of charge
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	minGasPrices := mgpd.tk.GetMinGasPrices(ctx)
+	gas := sdk.NewDec(int64(feeTx.GetGas()))
+
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+	for i, gp := range minGasPrices {
+		requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(gas).Ceil().RoundInt())
+	}
+
+	// A tx without fees is treated as paid in the base denom
+	txFees := feeTx.GetFee()
+	if txFees.Empty() {
+		if minGasPrices.AmountOf(mgpd.tk.BaseDenom(ctx)).IsPositive() {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "no fees provided; required: %s", requiredFees)
+		}
+
+		return next(ctx, tx, simulate)
+	}
+
+	// Fees in a denom without a floor are let through, the DeductTaxDecorator rejects the denoms that are not allowed
+	for _, feeCoin := range txFees {
+		if feeCoin.Amount.GTE(requiredFees.AmountOf(feeCoin.Denom)) {
+			return next(ctx, tx, simulate)
+		}
+	}
+
+	return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", txFees, requiredFees)
+}
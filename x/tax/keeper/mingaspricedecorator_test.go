@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+)
+
+func (suite *KeeperTestSuite) TestMinGasPriceDecorator() {
+	suite.SetupTest(true)
+
+	const approvedDenom = "uatom"
+	baseDenom := suite.app.TaxKeeper.BaseDenom(suite.ctx)
+	// the mock tx gas limit is sdktestutil.NewTestGasLimit(), i.e. 200000
+
+	testCases := []struct {
+		title       string
+		minGasPrice sdk.Dec
+		baseFee     sdk.Dec
+		fees        sdk.Coins
+		blockHeight int64
+		simulate    bool
+		expPass     bool
+	}{
+		{
+			title:       "fees covering the minimum gas price should pass",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 500)),
+			blockHeight: 1,
+			expPass:     true,
+		},
+		{
+			title:       "fees below the minimum gas price should fail",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 499)),
+			blockHeight: 1,
+			expPass:     false,
+		},
+		{
+			title:       "tx without fees should fail if there is a minimum gas price",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			fees:        sdk.Coins{},
+			blockHeight: 1,
+			expPass:     false,
+		},
+		{
+			title:       "tx without fees should pass if there is no minimum gas price",
+			minGasPrice: sdk.ZeroDec(),
+			fees:        sdk.Coins{},
+			blockHeight: 1,
+			expPass:     true,
+		},
+		{
+			title:       "fees in an approved denom covering its floor should pass",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(approvedDenom, 20)),
+			blockHeight: 1,
+			expPass:     true,
+		},
+		{
+			title:       "fees in an approved denom below its floor should fail",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(approvedDenom, 19)),
+			blockHeight: 1,
+			expPass:     false,
+		},
+		{
+			title:       "fees in an approved denom should be checked even if there is no minimum gas price in base denom",
+			minGasPrice: sdk.ZeroDec(),
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(approvedDenom, 19)),
+			blockHeight: 1,
+			expPass:     false,
+		},
+		{
+			title:       "fees covering the base fee should pass",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			baseFee:     sdk.MustNewDecFromStr("0.005"),
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			blockHeight: 1,
+			expPass:     true,
+		},
+		{
+			title:       "fees covering the minimum gas price but below the base fee should fail",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			baseFee:     sdk.MustNewDecFromStr("0.005"),
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 999)),
+			blockHeight: 1,
+			expPass:     false,
+		},
+		{
+			title:       "fees in an approved denom covering its floor scaled by the base fee should pass",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			baseFee:     sdk.MustNewDecFromStr("0.005"),
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(approvedDenom, 40)),
+			blockHeight: 1,
+			expPass:     true,
+		},
+		{
+			title:       "fees in an approved denom below its floor scaled by the base fee should fail",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			baseFee:     sdk.MustNewDecFromStr("0.005"),
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(approvedDenom, 39)),
+			blockHeight: 1,
+			expPass:     false,
+		},
+		{
+			title:       "simulated tx should pass regardless of the fees",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			fees:        sdk.Coins{},
+			blockHeight: 1,
+			simulate:    true,
+			expPass:     true,
+		},
+		{
+			title:       "genesis tx should pass regardless of the fees",
+			minGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			fees:        sdk.Coins{},
+			blockHeight: 0,
+			expPass:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest(true)
+
+		suite.Run(tc.title, func() {
+			params := suite.app.TaxKeeper.GetParams(suite.ctx)
+			params.MinGasPrice = tc.minGasPrice
+			params.FeeDenomMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(approvedDenom, sdk.MustNewDecFromStr("0.0001")))
+			suite.app.TaxKeeper.SetParams(suite.ctx, params)
+			if !tc.baseFee.IsNil() {
+				suite.app.TaxKeeper.SetBaseFee(suite.ctx, tc.baseFee)
+			}
+
+			_, _, addr := sdktestutil.KeyTestPubAddr()
+			tx := mockFeeTx{payer: addr, fee: tc.fees}
+
+			anteHandler := sdk.ChainAnteDecorators(keeper.NewMinGasPriceDecorator(suite.app.TaxKeeper))
+			_, err := anteHandler(suite.ctx.WithBlockHeight(tc.blockHeight), tx, tc.simulate)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee, tc.title)
+				return
+			}
+
+			suite.Require().NoError(err, tc.title)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MsgFilterDecorator rejects transactions containing any message of a governance disallowed type,
+// including the messages nested in an authz MsgExec. It allows the network to disable
+// specific messages in an emergency without an upgrade.
+// Call next AnteHandler if none of the messages is disallowed
+type MsgFilterDecorator struct {
+	tk Keeper
+}
+
+func NewMsgFilterDecorator(tk Keeper) MsgFilterDecorator {
+	return MsgFilterDecorator{
+		tk: tk,
+	}
+}
+
+func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if err = mfd.tk.CheckMsgTypes(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// CheckMsgTypes returns an error if any of the messages, including the messages nested in an authz MsgExec,
+// is of a governance disallowed type. Besides the txs, it applies to the messages dispatched by the contracts
+// and executed by the interchain accounts of other chains.
+func (k Keeper) CheckMsgTypes(ctx sdk.Context, msgs []sdk.Msg) error {
+	disallowed := k.GetDisallowedMsgTypes(ctx)
+	if len(disallowed) == 0 {
+		return nil
+	}
+
+	return checkMsgTypes(disallowed, msgs)
+}
+
+func checkMsgTypes(disallowed []string, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if msgTypeURL := sdk.MsgTypeURL(msg); types.ContainsMsgType(disallowed, msgTypeURL) {
+			return sdkerrors.Wrap(types.ErrDisallowedMsgType, msgTypeURL)
+		}
+
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			nestedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err = checkMsgTypes(disallowed, nestedMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgFilterDecorator() {
+	suite.SetupTest(true)
+
+	_, _, from := sdktestutil.KeyTestPubAddr()
+	_, _, to := sdktestutil.KeyTestPubAddr()
+	send := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("unls", 1)))
+	grant, err := authz.NewMsgGrant(from, to, authz.NewGenericAuthorization(sdk.MsgTypeURL(send)), time.Now().Add(time.Hour))
+	suite.Require().NoError(err)
+	exec := authz.NewMsgExec(to, []sdk.Msg{send})
+	nestedExec := authz.NewMsgExec(to, []sdk.Msg{&exec})
+
+	testCases := []struct {
+		title      string
+		disallowed []string
+		msgs       []sdk.Msg
+		expPass    bool
+	}{
+		{
+			title:   "tx should pass if there are no disallowed message types",
+			msgs:    []sdk.Msg{send, &exec},
+			expPass: true,
+		},
+		{
+			title:      "tx without disallowed messages should pass",
+			disallowed: []string{sdk.MsgTypeURL(grant)},
+			msgs:       []sdk.Msg{send, &exec},
+			expPass:    true,
+		},
+		{
+			title:      "tx with a disallowed message should fail",
+			disallowed: []string{sdk.MsgTypeURL(grant)},
+			msgs:       []sdk.Msg{send, grant},
+			expPass:    false,
+		},
+		{
+			title:      "tx with a disallowed message nested in authz exec should fail",
+			disallowed: []string{sdk.MsgTypeURL(send)},
+			msgs:       []sdk.Msg{&exec},
+			expPass:    false,
+		},
+		{
+			title:      "tx with a disallowed message nested in several authz execs should fail",
+			disallowed: []string{sdk.MsgTypeURL(send)},
+			msgs:       []sdk.Msg{&nestedExec},
+			expPass:    false,
+		},
+		{
+			title:      "tx with a disallowed authz exec should fail",
+			disallowed: []string{sdk.MsgTypeURL(&exec)},
+			msgs:       []sdk.Msg{&nestedExec},
+			expPass:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest(true)
+
+		suite.Run(tc.title, func() {
+			params := suite.app.TaxKeeper.GetParams(suite.ctx)
+			params.DisallowedMsgTypes = tc.disallowed
+			suite.app.TaxKeeper.SetParams(suite.ctx, params)
+
+			suite.Require().NoError(suite.txBuilder.SetMsgs(tc.msgs...))
+
+			anteHandler := sdk.ChainAnteDecorators(keeper.NewMsgFilterDecorator(suite.app.TaxKeeper))
+			_, err := anteHandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrDisallowedMsgType, tc.title)
+				return
+			}
+
+			suite.Require().NoError(err, tc.title)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgFilterRouter() {
+	suite.SetupTest(true)
+
+	_, _, from := sdktestutil.KeyTestPubAddr()
+	_, _, to := sdktestutil.KeyTestPubAddr()
+	suite.FundAcc(from, sdk.NewCoins(sdk.NewInt64Coin("unls", 2)))
+	send := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("unls", 1)))
+
+	router := keeper.NewMsgFilterRouter(suite.app.MsgServiceRouter(), &suite.app.TaxKeeper)
+	handler := router.Handler(send)
+	suite.Require().NotNil(handler)
+
+	_, err := handler(suite.ctx, send)
+	suite.Require().NoError(err)
+
+	params := suite.app.TaxKeeper.GetParams(suite.ctx)
+	params.DisallowedMsgTypes = []string{sdk.MsgTypeURL(send)}
+	suite.app.TaxKeeper.SetParams(suite.ctx, params)
+
+	// the messages dispatched by the contracts are rejected the same as the ones in a tx
+	_, err = handler(suite.ctx, send)
+	suite.Require().ErrorIs(err, types.ErrDisallowedMsgType)
+	suite.Require().Equal(sdk.NewInt64Coin("unls", 1), suite.app.BankKeeper.GetBalance(suite.ctx, to, "unls"))
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MessageRouter routes the messages to their handlers, e.g. the baseapp MsgServiceRouter.
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// MsgFilterRouter routes the messages dispatched outside of a tx, i.e. by the contracts,
+// rejecting the governance disallowed message types the same way as the MsgFilterDecorator.
+type MsgFilterRouter struct {
+	router MessageRouter
+	tk     *Keeper
+}
+
+// NewMsgFilterRouter creates a MsgFilterRouter routing the allowed messages with the given router.
+// The keeper is taken by reference since the contracts keeper, using the router, is created before it.
+func NewMsgFilterRouter(router MessageRouter, tk *Keeper) MsgFilterRouter {
+	return MsgFilterRouter{
+		router: router,
+		tk:     tk,
+	}
+}
+
+// Handler returns the handler of the message, which rejects it if its type is disallowed.
+func (r MsgFilterRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		if err := r.tk.CheckMsgTypes(ctx, []sdk.Msg{req}); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -11,6 +11,17 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.FeeRate(ctx),
 		k.ContractAddress(ctx),
 		k.BaseDenom(ctx),
+		k.MinGasPrice(ctx),
+		k.FeeDenomMinGasPrices(ctx),
+		k.MaxBaseFee(ctx),
+		k.TargetBlockGas(ctx),
+		k.BaseFeeChangeDenominator(ctx),
+		k.UnusedGasRefundRate(ctx),
+		k.GetDisallowedMsgTypes(ctx),
+		k.BypassMinFeeMsgTypes(ctx),
+		k.MaxBypassMinFeeMsgGasUsage(ctx),
+		k.PriceFeeders(ctx),
+		k.PriceFeedContracts(ctx),
 	)
 }
 
@@ -36,3 +47,89 @@ func (k Keeper) BaseDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyBaseDenom, &res)
 	return
 }
+
+// MinGasPrice returns the minimum gas price in base denom.
+func (k Keeper) MinGasPrice(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinGasPrice, &res)
+	return
+}
+
+// FeeDenomMinGasPrices returns the approved fee denoms, other than the base denom, with their minimum gas price.
+func (k Keeper) FeeDenomMinGasPrices(ctx sdk.Context) (res sdk.DecCoins) {
+	k.paramstore.Get(ctx, types.KeyFeeDenomMinGasPrices, &res)
+	return
+}
+
+// MaxBaseFee returns the upper bound of the base fee.
+func (k Keeper) MaxBaseFee(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxBaseFee, &res)
+	return
+}
+
+// TargetBlockGas returns the gas used per block at which the base fee stays unchanged.
+func (k Keeper) TargetBlockGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyTargetBlockGas, &res)
+	return
+}
+
+// BaseFeeChangeDenominator returns the denominator bounding the base fee change between two blocks.
+func (k Keeper) BaseFeeChangeDenominator(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyBaseFeeChangeDenominator, &res)
+	return
+}
+
+// UnusedGasRefundRate returns the fraction of the fee paid for the unused gas that is refunded.
+func (k Keeper) UnusedGasRefundRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyUnusedGasRefundRate, &res)
+	return
+}
+
+// GetDisallowedMsgTypes returns the type URLs of the messages rejected by the network.
+func (k Keeper) GetDisallowedMsgTypes(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyDisallowedMsgTypes, &res)
+	return
+}
+
+// BypassMinFeeMsgTypes returns the type URLs of the messages exempt from the minimum fee.
+func (k Keeper) BypassMinFeeMsgTypes(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyBypassMinFeeMsgTypes, &res)
+	return
+}
+
+// MaxBypassMinFeeMsgGasUsage returns the max gas limit of a transaction exempt from the minimum fee.
+func (k Keeper) MaxBypassMinFeeMsgGasUsage(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxBypassMinFeeMsgGasUsage, &res)
+	return
+}
+
+// PriceFeeders returns the addresses of the oracle price feeders.
+func (k Keeper) PriceFeeders(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyPriceFeeders, &res)
+	return
+}
+
+// PriceFeedContracts returns the addresses of the oracle contracts fed by the price feeders.
+func (k Keeper) PriceFeedContracts(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyPriceFeedContracts, &res)
+	return
+}
+
+// GetMinGasPrices returns the minimum gas price in force of the base denom and of every approved fee denom.
+// The base denom one is the base fee. The floors of the approved fee denoms follow the base fee
+// increase over the minimum gas price.
+func (k Keeper) GetMinGasPrices(ctx sdk.Context) sdk.DecCoins {
+	minGasPrice := k.MinGasPrice(ctx)
+	baseFee := k.GetBaseFee(ctx)
+
+	feeDenomMinGasPrices := k.FeeDenomMinGasPrices(ctx)
+	if minGasPrice.IsPositive() && baseFee.GT(minGasPrice) {
+		feeDenomMinGasPrices = feeDenomMinGasPrices.MulDec(baseFee.Quo(minGasPrice))
+	}
+
+	return feeDenomMinGasPrices.Add(sdk.NewDecCoinFromDec(k.BaseDenom(ctx), baseFee))
+}
+
+// IsFeeDenomAllowed returns whether fees can be paid in the given denom.
+func (k Keeper) IsFeeDenomAllowed(ctx sdk.Context, denom string) bool {
+	return denom == k.BaseDenom(ctx) || k.FeeDenomMinGasPrices(ctx).AmountOf(denom).IsPositive()
+}
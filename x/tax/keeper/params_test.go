@@ -18,4 +18,15 @@ func TestGetParams(t *testing.T) {
 	require.EqualValues(t, params.FeeRate, k.FeeRate(ctx))
 	require.EqualValues(t, params.ContractAddress, k.ContractAddress(ctx))
 	require.EqualValues(t, params.BaseDenom, k.BaseDenom(ctx))
+	require.EqualValues(t, params.MinGasPrice, k.MinGasPrice(ctx))
+	require.EqualValues(t, params.FeeDenomMinGasPrices, k.FeeDenomMinGasPrices(ctx))
+	require.EqualValues(t, params.MaxBaseFee, k.MaxBaseFee(ctx))
+	require.EqualValues(t, params.TargetBlockGas, k.TargetBlockGas(ctx))
+	require.EqualValues(t, params.BaseFeeChangeDenominator, k.BaseFeeChangeDenominator(ctx))
+	require.EqualValues(t, params.UnusedGasRefundRate, k.UnusedGasRefundRate(ctx))
+	require.EqualValues(t, params.DisallowedMsgTypes, k.GetDisallowedMsgTypes(ctx))
+	require.EqualValues(t, params.BypassMinFeeMsgTypes, k.BypassMinFeeMsgTypes(ctx))
+	require.EqualValues(t, params.MaxBypassMinFeeMsgGasUsage, k.MaxBypassMinFeeMsgGasUsage(ctx))
+	require.EqualValues(t, params.PriceFeeders, k.PriceFeeders(ctx))
+	require.EqualValues(t, params.PriceFeedContracts, k.PriceFeedContracts(ctx))
 }
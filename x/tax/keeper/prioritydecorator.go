@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PriorityDecorator sets the priority of the transactions in the Tendermint priority mempool.
+// The transactions of the governance approved oracle price feeders executing only the price feed contracts
+// go first, so that stale prices do not delay the liquidations. The others are ordered by the gas price of their fee.
+// The priority is tracked by the keeper until the app sets it on the CheckTx response.
+// Call next AnteHandler after recording the priority of the tx in CheckTx
+// CONTRACT: Tx must implement FeeTx interface to use PriorityDecorator.
+type PriorityDecorator struct {
+	tk Keeper
+}
+
+func NewPriorityDecorator(tk Keeper) PriorityDecorator {
+	return PriorityDecorator{
+		tk: tk,
+	}
+}
+
+func (pd PriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// The priority matters to the mempool only
+	if ctx.IsCheckTx() && !simulate {
+		pd.tk.TrackTxPriority(ctx.TxBytes(), pd.tk.GetTxPriority(ctx, feeTx))
+	}
+
+	return next(ctx, tx, simulate)
+}
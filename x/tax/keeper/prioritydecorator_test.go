@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+)
+
+func (suite *KeeperTestSuite) TestPriorityDecorator() {
+	suite.SetupTest(true)
+
+	_, _, feeder := sdktestutil.KeyTestPubAddr()
+	_, _, oracle := sdktestutil.KeyTestPubAddr()
+	_, _, other := sdktestutil.KeyTestPubAddr()
+
+	baseDenom := suite.app.TaxKeeper.BaseDenom(suite.ctx)
+	feedPrice := &wasmtypes.MsgExecuteContract{Sender: feeder.String(), Contract: oracle.String(), Msg: []byte("{}")}
+	executeOther := &wasmtypes.MsgExecuteContract{Sender: feeder.String(), Contract: other.String(), Msg: []byte("{}")}
+	send := banktypes.NewMsgSend(feeder, other, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1)))
+
+	testCases := []struct {
+		title       string
+		msgs        []sdk.Msg
+		fees        sdk.Coins
+		deliverTx   bool
+		expPriority int64
+	}{
+		{
+			title:       "price feed from a feeder goes first",
+			msgs:        []sdk.Msg{feedPrice, feedPrice},
+			expPriority: keeper.PriceFeedPriority,
+		},
+		{
+			title:       "feeder executing another contract is prioritized by fee",
+			msgs:        []sdk.Msg{feedPrice, executeOther},
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 500)),
+			expPriority: sdk.MustNewDecFromStr("0.0025").BigInt().Int64(),
+		},
+		{
+			title:       "other tx is prioritized by the gas price of the fee",
+			msgs:        []sdk.Msg{send},
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			expPriority: sdk.MustNewDecFromStr("0.005").BigInt().Int64(),
+		},
+		{
+			title:       "free tx has no priority",
+			msgs:        []sdk.Msg{send},
+			expPriority: 0,
+		},
+		{
+			title:       "priority is not tracked in DeliverTx",
+			msgs:        []sdk.Msg{feedPrice},
+			deliverTx:   true,
+			expPriority: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest(true)
+
+		suite.Run(tc.title, func() {
+			params := suite.app.TaxKeeper.GetParams(suite.ctx)
+			params.PriceFeeders = []string{feeder.String()}
+			params.PriceFeedContracts = []string{oracle.String()}
+			suite.app.TaxKeeper.SetParams(suite.ctx, params)
+
+			suite.Require().NoError(suite.txBuilder.SetMsgs(tc.msgs...))
+			suite.txBuilder.SetGasLimit(200000)
+			suite.txBuilder.SetFeeAmount(tc.fees)
+
+			txBytes := []byte(tc.title)
+			ctx := suite.ctx.WithTxBytes(txBytes).WithIsCheckTx(!tc.deliverTx)
+
+			anteHandler := sdk.ChainAnteDecorators(keeper.NewPriorityDecorator(suite.app.TaxKeeper))
+			_, err := anteHandler(ctx, suite.txBuilder.GetTx(), false)
+			suite.Require().NoError(err, tc.title)
+
+			suite.Require().Equal(tc.expPriority, suite.app.TaxKeeper.PopTxPriority(txBytes), tc.title)
+			suite.Require().Zero(suite.app.TaxKeeper.PopTxPriority(txBytes), tc.title)
+		})
+	}
+}
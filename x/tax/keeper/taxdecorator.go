@@ -6,26 +6,22 @@ import (
 	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-var HUNDRED_DEC = sdk.NewDec(100)
-
-// DeductTaxDecorator deducts tax by a given fee rate from the standard collected fee.
-// The tax is sent to a treasury account
-// Call next AnteHandler if tax successfully sent to treasury or no fee provided
+// DeductTaxDecorator validates the fee and keeps it until the EndBlocker, where the gas used by the tx is known.
+// The EndBlocker refunds the fee of the unused gas and sends the tax by a given fee rate
+// on the fee actually consumed to a treasury account.
+// Call next AnteHandler if the fee is successfully kept or no fee provided
 // CONTRACT: Tx must implement FeeTx interface to use DeductTaxDecorator.
 type DeductTaxDecorator struct {
 	ak types.AccountKeeper
 	tk Keeper
-	bk types.BankKeeper
 }
 
-func NewDeductTaxDecorator(ak types.AccountKeeper, bk types.BankKeeper, tk Keeper) DeductTaxDecorator {
+func NewDeductTaxDecorator(ak types.AccountKeeper, tk Keeper) DeductTaxDecorator {
 	return DeductTaxDecorator{
 		ak: ak,
 		tk: tk,
-		bk: bk,
 	}
 }
 
@@ -38,12 +34,13 @@ func (dtd DeductTaxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	// If fees are not specified we call the next AnteHandler
 	txFees := feeTx.GetFee()
 	if txFees.Empty() {
+		// the gas of a tx without fee is still consumed by the block, so it is not counted toward other txs
+		dtd.keepTxFee(ctx, feeTx, sdk.NewCoin(dtd.tk.BaseDenom(ctx), sdk.ZeroInt()), simulate)
 		return next(ctx, tx, simulate)
 	}
 
 	// Ensures the module treasury address has been set
-	treasuryAddr, err := sdk.AccAddressFromBech32(dtd.tk.ContractAddress(ctx))
-	if err != nil {
+	if _, err = sdk.AccAddressFromBech32(dtd.tk.ContractAddress(ctx)); err != nil {
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnknownAddress, fmt.Sprintf("invalid treasury smart contract address: %s", err.Error()))
 	}
 
@@ -61,14 +58,11 @@ func (dtd DeductTaxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, err
 	}
 
-	baseDenom := dtd.tk.BaseDenom(ctx)
-	if baseDenom != feeCoin.Denom {
+	if !dtd.tk.IsFeeDenomAllowed(ctx, feeCoin.Denom) {
 		return ctx, sdkerrors.Wrap(types.ErrInvalidFeeDenom, txFees[0].Denom)
 	}
 
-	if err = deductTax(ctx, dtd.tk, dtd.bk, feeCoin, treasuryAddr); err != nil {
-		return ctx, err
-	}
+	dtd.keepTxFee(ctx, feeTx, feeCoin, simulate)
 
 	events := sdk.Events{sdk.NewEvent(sdk.EventTypeTx,
 		sdk.NewAttribute(sdk.AttributeKeyFee, feeTx.GetFee().String()),
@@ -79,32 +73,23 @@ func (dtd DeductTaxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
-func deductTax(ctx sdk.Context, taxKeeper Keeper, bankKeeper types.BankKeeper, feeCoin sdk.Coin, treasuryAddr sdk.AccAddress) error {
-	feeRate := sdk.NewDec(int64(taxKeeper.FeeRate(ctx)))
-	// if feeRate is 0 - we won't deduct any tax
-	if feeRate.IsZero() {
-		return nil
-	}
-
-	tax := sdk.NewCoin(feeCoin.Denom, feeRate.MulInt(feeCoin.Amount).Quo(HUNDRED_DEC).TruncateInt())
-	// There are cases where the tax calculation could result in a number between 0 and 1.
-	// In those cases, the tax will be 0, since the lowest registered unit we have is 1unls
-	// **Note - this case probably won't be reached in reality, because we enforce minimum fees(500 currently). So the feeAmount is always expected to be > 500.
-	if tax.IsZero() {
-		return nil
-	}
-
-	if !tax.IsValid() {
-		return types.ErrInvalidTax
+// keepTxFee keeps the fee of a delivered tx until the EndBlocker together with the gas consumed
+// by the block so far. The gas used by the tx is the block gas consumed until the next kept fee.
+// The fee collector holds the fee until then, since it is deducted by the ante handler of the very same tx.
+func (dtd DeductTaxDecorator) keepTxFee(ctx sdk.Context, feeTx sdk.FeeTx, feeCoin sdk.Coin, simulate bool) {
+	if ctx.IsCheckTx() || simulate {
+		return
 	}
 
-	ctx.Logger().Info(fmt.Sprintf("Deducted tax: %s, final fee: %s", tax, feeCoin.Sub(tax)))
-
-	// Send tax from fee collector to the treasury smart contract address
-	err := bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasuryAddr, sdk.Coins{tax})
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	payer := feeTx.FeePayer()
+	if feeTx.FeeGranter() != nil {
+		payer = feeTx.FeeGranter()
 	}
 
-	return nil
+	dtd.tk.AppendTxFee(ctx, types.TxFee{
+		Payer:        payer.String(),
+		Fee:          feeCoin,
+		GasLimit:     feeTx.GetGas(),
+		BlockGasUsed: ctx.BlockGasMeter().GasConsumed(),
+	})
 }
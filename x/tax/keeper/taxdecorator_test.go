@@ -1,12 +1,20 @@
 package keeper_test
 
 import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/tax"
 	"github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
 	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
 )
@@ -23,6 +31,7 @@ func (suite *KeeperTestSuite) TestTaxDecorator() {
 		feeDenoms []string
 		feeAmount sdk.Int
 		feeRate   int32
+		approved  sdk.DecCoins
 		expPass   bool
 		expErr    error
 	}{
@@ -74,6 +83,15 @@ func (suite *KeeperTestSuite) TestTaxDecorator() {
 			expPass:   false,
 			expErr:    types.ErrInvalidFeeDenom,
 		},
+		{
+			title:     "pay fees with approved denom should increase the treasury balance",
+			feeDenoms: []string{rnDenom},
+			feeAmount: sdk.NewInt(100),
+			feeRate:   40,
+			approved:  sdk.NewDecCoins(sdk.NewDecCoinFromDec(rnDenom, sdk.OneDec())),
+			expPass:   true,
+			expErr:    nil,
+		},
 		{
 			title:     "pay fees with multiple denoms should fail",
 			feeDenoms: []string{baseDenom, rnDenom},
@@ -87,6 +105,8 @@ func (suite *KeeperTestSuite) TestTaxDecorator() {
 	for _, tc := range testCases {
 		// reset pool and accounts for each test
 		suite.SetupTest(true)
+		// the fee is kept for the settlement only when the tx is delivered
+		suite.ctx = suite.ctx.WithIsCheckTx(false)
 
 		suite.Run(tc.title, func() {
 			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -120,11 +140,12 @@ func (suite *KeeperTestSuite) TestTaxDecorator() {
 			// set fee rate
 			params := suite.app.TaxKeeper.GetParams(suite.ctx)
 			params.FeeRate = tc.feeRate
+			params.FeeDenomMinGasPrices = tc.approved
 			suite.app.TaxKeeper.SetParams(suite.ctx, params)
 
 			// get chained ante handler
 			dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
-			dtd := keeper.NewDeductTaxDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TaxKeeper)
+			dtd := keeper.NewDeductTaxDecorator(suite.app.AccountKeeper, suite.app.TaxKeeper)
 			anteHandler := sdk.ChainAnteDecorators(dfd, dtd)
 
 			// retrieve treasury address
@@ -150,22 +171,239 @@ func (suite *KeeperTestSuite) TestTaxDecorator() {
 			// pass is expected
 			suite.Require().NoError(err, "test: %s", tc.title)
 
+			// the tax is only accumulated by the ante handler and settled at the end of the block
 			expTreasuryBalance := sdk.Coins{} // empty treasury
 			treasuryBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, treasuryAddr)
+			suite.Require().Equal(expTreasuryBalance, treasuryBalance, "Treasury should be empty before the end of the block")
+
+			tax.EndBlocker(suite.ctx, suite.app.TaxKeeper, suite.app.BankKeeper)
+			suite.Require().True(suite.app.TaxKeeper.GetCollectedTax(suite.ctx).Empty(), "Collected tax should be cleared after settlement")
+
+			treasuryBalance = suite.app.BankKeeper.GetAllBalances(suite.ctx, treasuryAddr)
 			feeRate := sdk.NewDec(int64(tc.feeRate))
-			tax := feeRate.MulInt(tc.feeAmount).Quo(HUNDRED_DEC).TruncateInt()
+			taxAmount := feeRate.MulInt(tc.feeAmount).Quo(HUNDRED_DEC).TruncateInt()
 
-			if txFees.Empty() || tc.feeRate == 0 || tax.LT(sdk.NewInt(1)) {
+			if txFees.Empty() || tc.feeRate == 0 || taxAmount.LT(sdk.NewInt(1)) {
 				suite.Require().Equal(expTreasuryBalance, treasuryBalance, "Treasury should be empty")
 				return
 			}
 
 			feeDenom := tc.feeDenoms[0]
 			expTreasuryBalance = expTreasuryBalance.Add(
-				sdk.NewCoin(feeDenom, tax),
+				sdk.NewCoin(feeDenom, taxAmount),
 			)
 
 			suite.Require().Equal(expTreasuryBalance, treasuryBalance, "Treasury should have collected correct tax amount")
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTaxSettlement() {
+	suite.SetupTest(true)
+
+	baseDenom := suite.app.TaxKeeper.BaseDenom(suite.ctx)
+	feeRate := suite.app.TaxKeeper.FeeRate(suite.ctx)
+	feeAmount := sdk.NewInt(1000)
+	failingDecorator := sdk.AnteDecorator(failDecorator{})
+
+	testCases := []struct {
+		title      string
+		decorators []sdk.AnteDecorator
+		expPass    bool
+	}{
+		{
+			title:   "tax of a successful tx should be settled to the treasury",
+			expPass: true,
+		},
+		{
+			title:      "tax of a tx rejected after the tax decorator should not be settled",
+			decorators: []sdk.AnteDecorator{failingDecorator},
+			expPass:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest(true)
+		suite.ctx = suite.ctx.WithIsCheckTx(false)
+
+		suite.Run(tc.title, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			accs := suite.CreateTestAccounts(1)
+			addr := accs[0].acc.GetAddress()
+			suite.FundAcc(addr, sdk.NewCoins(sdk.NewCoin(baseDenom, feeAmount)))
+
+			suite.txBuilder.SetGasLimit(sdktestutil.NewTestGasLimit())
+			suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(baseDenom, feeAmount)))
+			suite.Require().NoError(suite.txBuilder.SetMsgs(sdktestutil.NewTestMsg(addr)))
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			decorators := []sdk.AnteDecorator{
+				ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil),
+				keeper.NewDeductTaxDecorator(suite.app.AccountKeeper, suite.app.TaxKeeper),
+			}
+			anteHandler := sdk.ChainAnteDecorators(append(decorators, tc.decorators...)...)
+
+			// the ante handler writes are only persisted if it succeeds, as done by the baseapp
+			cacheCtx, write := suite.ctx.CacheContext()
+			_, err = anteHandler(cacheCtx, tx, false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				write()
+			} else {
+				suite.Require().Error(err)
+			}
+
+			treasuryAddr, err := sdk.AccAddressFromBech32(suite.app.TaxKeeper.ContractAddress(suite.ctx))
+			suite.Require().NoError(err)
+
+			tax.EndBlocker(suite.ctx, suite.app.TaxKeeper, suite.app.BankKeeper)
+
+			expTreasuryBalance := sdk.Coins{}
+			if tc.expPass {
+				taxAmount := sdk.NewDec(int64(feeRate)).MulInt(feeAmount).Quo(keeper.HUNDRED_DEC).TruncateInt()
+				expTreasuryBalance = sdk.NewCoins(sdk.NewCoin(baseDenom, taxAmount))
+			}
+			suite.Require().Equal(expTreasuryBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, treasuryAddr))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCollectedTaxAccumulation() {
+	suite.SetupTest(true)
+
+	baseDenom := suite.app.TaxKeeper.BaseDenom(suite.ctx)
+	suite.Require().True(suite.app.TaxKeeper.GetCollectedTax(suite.ctx).Empty())
+
+	suite.app.TaxKeeper.AppendTxFee(suite.ctx, types.TxFee{Fee: sdk.NewInt64Coin(baseDenom, 100)})
+	suite.app.TaxKeeper.AppendTxFee(suite.ctx, types.TxFee{Fee: sdk.NewInt64Coin(baseDenom, 5)})
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 42)), suite.app.TaxKeeper.GetCollectedTax(suite.ctx))
+
+	suite.app.TaxKeeper.ClearTxFees(suite.ctx)
+	suite.Require().True(suite.app.TaxKeeper.GetCollectedTax(suite.ctx).Empty())
+}
+
+func (suite *KeeperTestSuite) TestTxFeeSettlementByBlockGas() {
+	suite.SetupTest(true)
+
+	baseDenom := suite.app.TaxKeeper.BaseDenom(suite.ctx)
+	params := suite.app.TaxKeeper.GetParams(suite.ctx)
+	params.UnusedGasRefundRate = sdk.OneDec()
+	suite.app.TaxKeeper.SetParams(suite.ctx, params)
+
+	accs := suite.CreateTestAccounts(2)
+	payers := []sdk.AccAddress{accs[0].acc.GetAddress(), accs[1].acc.GetAddress()}
+	treasuryAddr := sdk.MustAccAddressFromBech32(params.ContractAddress)
+
+	// the fees are deducted to the fee collector by the ante handler
+	fees := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2000))
+	suite.Require().NoError(simapp.FundModuleAccount(suite.app.BankKeeper, suite.ctx, authtypes.FeeCollectorName, fees))
+
+	// the first tx uses a quarter of its gas limit, the second one runs out of gas
+	blockGasMeter := sdk.NewInfiniteGasMeter()
+	ctx := suite.ctx.WithBlockGasMeter(blockGasMeter)
+	suite.app.TaxKeeper.AppendTxFee(ctx, types.TxFee{Payer: payers[0].String(), Fee: sdk.NewInt64Coin(baseDenom, 1000), GasLimit: 100_000})
+	blockGasMeter.ConsumeGas(25_000, "first tx")
+	suite.app.TaxKeeper.AppendTxFee(ctx, types.TxFee{Payer: payers[1].String(), Fee: sdk.NewInt64Coin(baseDenom, 1000), GasLimit: 100_000, BlockGasUsed: blockGasMeter.GasConsumed()})
+	blockGasMeter.ConsumeGas(100_000, "second tx")
+
+	tax.EndBlocker(ctx, suite.app.TaxKeeper, suite.app.BankKeeper)
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 750)), suite.app.BankKeeper.GetAllBalances(ctx, payers[0]))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, payers[1]).Empty())
+	// 40% of the 250 consumed by the first tx and of the 1000 consumed by the second one
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 500)), suite.app.BankKeeper.GetAllBalances(ctx, treasuryAddr))
+	suite.Require().True(suite.app.TaxKeeper.GetCollectedTax(ctx).Empty())
+}
+
+// failDecorator rejects every tx, emulating a failure later in the ante chain.
+type failDecorator struct{}
+
+func (failDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, sdkerrors.ErrUnauthorized
+}
+
+// mockFeeTx is a minimal FeeTx paid by a single account.
+type mockFeeTx struct {
+	payer sdk.AccAddress
+	fee   sdk.Coins
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg         { return nil }
+func (tx mockFeeTx) ValidateBasic() error       { return nil }
+func (tx mockFeeTx) GetGas() uint64             { return sdktestutil.NewTestGasLimit() }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return tx.payer }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return nil }
+
+// transferTaxDecorator sends the tax to the treasury on every tx, the way it was done before the
+// settlement was moved to the EndBlocker. It serves as a baseline for the benchmark.
+type transferTaxDecorator struct {
+	tk keeper.Keeper
+	bk types.BankKeeper
+}
+
+func (ttd transferTaxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeCoin := tx.(sdk.FeeTx).GetFee()[0]
+	treasuryAddr := sdk.MustAccAddressFromBech32(ttd.tk.ContractAddress(ctx))
+	taxAmount := sdk.NewDec(int64(ttd.tk.FeeRate(ctx))).MulInt(feeCoin.Amount).Quo(keeper.HUNDRED_DEC).TruncateInt()
+	if err := ttd.bk.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasuryAddr, sdk.NewCoins(sdk.NewCoin(feeCoin.Denom, taxAmount))); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func BenchmarkTaxSettlement(b *testing.B) {
+	const txsPerBlock = 1000
+
+	setup := func(b *testing.B) (*nolusapp.App, sdk.Context, mockFeeTx) {
+		app, ctx := nolusapp.CreateTestApp(true, b.TempDir())
+		ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()).WithIsCheckTx(false)
+		baseDenom := app.TaxKeeper.BaseDenom(ctx)
+
+		_, _, addr := sdktestutil.KeyTestPubAddr()
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+		err := simapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewCoin(baseDenom, sdk.NewInt(1_000_000_000_000_000))))
+		require.NoError(b, err)
+
+		return app, ctx, mockFeeTx{payer: addr, fee: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000))}
+	}
+
+	b.Run("transfer per tx", func(b *testing.B) {
+		app, ctx, tx := setup(b)
+		anteHandler := sdk.ChainAnteDecorators(
+			ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, nil),
+			transferTaxDecorator{tk: app.TaxKeeper, bk: app.BankKeeper},
+		)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := anteHandler(ctx, tx, false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("settle in end blocker", func(b *testing.B) {
+		app, ctx, tx := setup(b)
+		anteHandler := sdk.ChainAnteDecorators(
+			ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, nil),
+			keeper.NewDeductTaxDecorator(app.AccountKeeper, app.TaxKeeper),
+		)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := anteHandler(ctx, tx, false); err != nil {
+				b.Fatal(err)
+			}
+			if (i+1)%txsPerBlock == 0 {
+				tax.EndBlocker(ctx, app.TaxKeeper, app.BankKeeper)
+			}
+		}
+		tax.EndBlocker(ctx, app.TaxKeeper, app.BankKeeper)
+	})
+}
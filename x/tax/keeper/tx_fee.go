@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppendTxFee keeps the fee paid by a transaction of the current block until the end of the block.
+// The fees are kept in the transient store in the order the transactions are delivered,
+// so that the gas used by each of them can be told from the block gas of the next one.
+func (k Keeper) AppendTxFee(ctx sdk.Context, txFee types.TxFee) {
+	store := ctx.TransientStore(k.tStoreKey)
+
+	var seq uint64
+	if bz := store.Get(types.TxFeeCountKey); bz != nil {
+		seq = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.TxFeeKey(seq), k.cdc.MustMarshal(&txFee))
+	store.Set(types.TxFeeCountKey, sdk.Uint64ToBigEndian(seq+1))
+}
+
+// IterateTxFees iterates over the fees paid by the transactions of the current block in the order of delivery.
+func (k Keeper) IterateTxFees(ctx sdk.Context, cb func(txFee types.TxFee) (stop bool)) {
+	store := prefix.NewStore(ctx.TransientStore(k.tStoreKey), types.TxFeePrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var txFee types.TxFee
+		k.cdc.MustUnmarshal(iterator.Value(), &txFee)
+		if cb(txFee) {
+			break
+		}
+	}
+}
+
+// ClearTxFees drops the fees paid by the transactions of the current block.
+func (k Keeper) ClearTxFees(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.TransientStore(k.tStoreKey), types.TxFeePrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	ctx.TransientStore(k.tStoreKey).Delete(types.TxFeeCountKey)
+}
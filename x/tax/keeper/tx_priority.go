@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceFeedPriority is the mempool priority of the oracle price feed transactions, above any fee based priority.
+const PriceFeedPriority int64 = math.MaxInt64
+
+// txPriorityTracker keeps the priority of the transactions checked by the ante handler by tx hash.
+// The SDK context has no priority, so it is kept in memory until the app sets it on the CheckTx response.
+type txPriorityTracker struct {
+	priority map[string]int64
+}
+
+// TrackTxPriority records the mempool priority of the transaction being checked.
+func (k Keeper) TrackTxPriority(txBytes []byte, priority int64) {
+	k.priorityTracker.priority[string(tmhash.Sum(txBytes))] = priority
+}
+
+// PopTxPriority returns and drops the mempool priority of the checked transaction.
+// The priority is zero if the transaction did not reach the PriorityDecorator.
+func (k Keeper) PopTxPriority(txBytes []byte) int64 {
+	key := string(tmhash.Sum(txBytes))
+	priority := k.priorityTracker.priority[key]
+	delete(k.priorityTracker.priority, key)
+
+	return priority
+}
+
+// GetTxPriority returns the mempool priority of the transaction. The oracle price feeds get PriceFeedPriority,
+// any other transaction the gas price of its fee, converted to the base denom at the ratio of the minimum gas prices,
+// in units of 10^-18 of the base denom.
+func (k Keeper) GetTxPriority(ctx sdk.Context, tx sdk.FeeTx) int64 {
+	if k.isPriceFeedTx(ctx, tx.GetMsgs()) {
+		return PriceFeedPriority
+	}
+
+	gas := tx.GetGas()
+	if gas == 0 {
+		return 0
+	}
+
+	minGasPrices := k.GetMinGasPrices(ctx)
+	baseMinGasPrice := minGasPrices.AmountOf(k.BaseDenom(ctx))
+
+	var priority int64
+	for _, feeCoin := range tx.GetFee() {
+		gasPrice := sdk.NewDecFromInt(feeCoin.Amount).QuoInt64(int64(gas))
+		if minGasPrice := minGasPrices.AmountOf(feeCoin.Denom); minGasPrice.IsPositive() && baseMinGasPrice.IsPositive() {
+			gasPrice = gasPrice.Mul(baseMinGasPrice).Quo(minGasPrice)
+		}
+
+		// the fee based priority stays below the price feeds one
+		p := PriceFeedPriority - 1
+		if gasPrice.BigInt().IsInt64() && gasPrice.BigInt().Int64() < p {
+			p = gasPrice.BigInt().Int64()
+		}
+
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
+
+// isPriceFeedTx returns whether all messages execute a price feed contract on behalf of a price feeder.
+func (k Keeper) isPriceFeedTx(ctx sdk.Context, msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	feeders := k.PriceFeeders(ctx)
+	contracts := k.PriceFeedContracts(ctx)
+	if len(feeders) == 0 || len(contracts) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		execute, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok || !types.ContainsAddress(feeders, execute.Sender) || !types.ContainsAddress(contracts, execute.Contract) {
+			return false
+		}
+	}
+
+	return true
+}
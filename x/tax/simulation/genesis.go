@@ -6,6 +6,7 @@ import (
 	"math/rand"
 
 	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -22,7 +23,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		simState.Cdc, string(types.KeyFeeRate), &feeRate, simState.Rand,
 		func(r *rand.Rand) { feeRate = GenRandomFeeRate(r) },
 	)
-	params := types.NewParams(feeRate, types.DefaultContractAddress, types.DefaultBaseDenom)
+	// the simulated transactions pay random fees, so the minimum gas price is not enforced
+	params := types.NewParams(feeRate, types.DefaultContractAddress, types.DefaultBaseDenom, sdk.ZeroDec(), types.DefaultFeeDenomMinGasPrices,
+		types.DefaultMaxBaseFee, types.DefaultTargetBlockGas, types.DefaultBaseFeeChangeDenominator, types.DefaultUnusedGasRefundRate, types.DefaultDisallowedMsgTypes,
+		types.DefaultBypassMinFeeMsgTypes, types.DefaultMaxBypassMinFeeMsgGasUsage, types.DefaultPriceFeeders, types.DefaultPriceFeedContracts)
 
 	taxGenesis := types.NewGenesisState(params)
 
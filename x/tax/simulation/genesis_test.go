@@ -43,6 +43,8 @@ func TestRandomizedGenState(t *testing.T) {
 	require.GreaterOrEqual(t, taxGenesis.Params.FeeRate, int32(1))
 	require.GreaterOrEqual(t, int32(100), taxGenesis.Params.FeeRate)
 	require.Equal(t, "nolus14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s0k0puz", taxGenesis.Params.ContractAddress)
+	require.True(t, taxGenesis.Params.MinGasPrice.IsZero())
+	require.Empty(t, taxGenesis.Params.FeeDenomMinGasPrices)
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
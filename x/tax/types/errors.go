@@ -8,10 +8,14 @@ import (
 
 // x/tax module sentinel errors.
 var (
-	ErrInvalidFeeRate  = sdkerrors.Register(ModuleName, 1, "feeRate should be between 0 and 50")
-	ErrInvalidAddress  = sdkerrors.Register(ModuleName, 2, "invalid address")
-	ErrTooManyFeeCoins = sdkerrors.Register(ModuleName, 3, "only one fee denom per tx")
-	ErrInvalidFeeDenom = sdkerrors.Register(ModuleName, 4, "denom is not allowed")
-	ErrAmountNilOrZero = sdkerrors.Register(ModuleName, 5, "amount can not be nil or zero")
-	ErrInvalidTax      = sdkerrors.Register(ModuleName, 6, "tax can not be negative, zero or nil")
+	ErrInvalidFeeRate     = sdkerrors.Register(ModuleName, 1, "feeRate should be between 0 and 50")
+	ErrInvalidAddress     = sdkerrors.Register(ModuleName, 2, "invalid address")
+	ErrTooManyFeeCoins    = sdkerrors.Register(ModuleName, 3, "only one fee denom per tx")
+	ErrInvalidFeeDenom    = sdkerrors.Register(ModuleName, 4, "denom is not allowed")
+	ErrAmountNilOrZero    = sdkerrors.Register(ModuleName, 5, "amount can not be nil or zero")
+	ErrInvalidTax         = sdkerrors.Register(ModuleName, 6, "tax can not be negative, zero or nil")
+	ErrInvalidMinGasPrice = sdkerrors.Register(ModuleName, 7, "invalid minimum gas price")
+	ErrInvalidBaseFee     = sdkerrors.Register(ModuleName, 8, "invalid base fee")
+	ErrInvalidRefundRate  = sdkerrors.Register(ModuleName, 9, "unused gas refund rate should be between 0 and 1")
+	ErrDisallowedMsgType  = sdkerrors.Register(ModuleName, 10, "message type is disallowed")
 )
@@ -0,0 +1,13 @@
+package types
+
+// Tax module event types.
+const (
+	EventTypeSettleTax = "settle_tax"
+	EventTypeBaseFee   = "base_fee"
+	EventTypeRefundFee = "refund_fee"
+
+	AttributeKeyTreasury = "treasury"
+	AttributeKeyGasUsed  = "gas_used"
+	AttributeKeyBaseFee  = "base_fee"
+	AttributeKeyPayer    = "payer"
+)
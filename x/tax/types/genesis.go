@@ -4,21 +4,30 @@ package types
 const DefaultIndex uint64 = 1
 
 // NewGenesisState creates a new GenesisState object.
+// The base fee starts from the minimum gas price.
 func NewGenesisState(params Params) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:  params,
+		BaseFee: params.MinGasPrice,
 	}
 }
 
 // DefaultGenesis returns the default Capability genesis state.
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{
-		Params: DefaultParams(),
-	}
+	return NewGenesisState(DefaultParams())
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// a missing base fee is initialized to the minimum gas price
+	if !gs.BaseFee.IsNil() && gs.BaseFee.IsNegative() {
+		return ErrInvalidBaseFee
+	}
+
+	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -26,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the tax module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// base_fee is the base fee in force at genesis.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -75,19 +78,23 @@ func init() {
 func init() { proto.RegisterFile("tax/genesis.proto", fileDescriptor_8aca70e5a5da354c) }
 
 var fileDescriptor_8aca70e5a5da354c = []byte{
-	// 190 bytes of a gzipped FileDescriptorProto
+	// 243 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2c, 0x49, 0xac, 0xd0,
 	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2e,
 	0x49, 0xac, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xf3, 0xf5, 0x41, 0x2c, 0x88, 0x94, 0x94,
-	0x00, 0x48, 0x75, 0x41, 0x62, 0x51, 0x62, 0x2e, 0x54, 0xb1, 0x92, 0x25, 0x17, 0x8f, 0x3b, 0x44,
-	0x77, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x26, 0x17, 0x1b, 0x44, 0x5e, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xdb, 0x88, 0x5b, 0xaf, 0x24, 0xb1, 0x42, 0x2f, 0x00, 0x2c, 0xe4, 0xc4, 0x72, 0xe2, 0x9e,
-	0x3c, 0x43, 0x10, 0x54, 0x81, 0x93, 0xd7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0x19, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xfb, 0xe5, 0xe7,
-	0x94, 0x16, 0xeb, 0x06, 0x80, 0x2c, 0x4b, 0xce, 0xcf, 0xd1, 0xcf, 0x03, 0x73, 0x93, 0xf3, 0x8b,
-	0x52, 0xf5, 0x2b, 0xf4, 0x41, 0xae, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xbb, 0xc6,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xef, 0x7d, 0xa5, 0xcf, 0x00, 0x00, 0x00,
+	0x00, 0x48, 0x75, 0x41, 0x62, 0x51, 0x62, 0x2e, 0x54, 0xb1, 0x52, 0x0b, 0x23, 0x17, 0x8f, 0x3b,
+	0x44, 0x7b, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x26, 0x17, 0x1b, 0x44, 0x81, 0x04, 0xa3, 0x02,
+	0xa3, 0x06, 0xb7, 0x11, 0xb7, 0x5e, 0x49, 0x62, 0x85, 0x5e, 0x00, 0x58, 0xc8, 0x89, 0xe5, 0xc4,
+	0x3d, 0x79, 0x86, 0x20, 0xa8, 0x02, 0x21, 0x4f, 0x2e, 0x8e, 0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4,
+	0xd4, 0x54, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x3d, 0x90, 0xfc, 0xad, 0x7b, 0xf2, 0x6a,
+	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9,
+	0xc5, 0x50, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x58, 0xcf, 0x25, 0x35,
+	0x39, 0x88, 0x1d, 0xa4, 0xdf, 0x2d, 0x35, 0xd5, 0xc9, 0xeb, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
+	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
+	0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0x90, 0x8c, 0xf2, 0xcb, 0xcf, 0x29, 0x2d, 0xd6, 0x0d, 0x00, 0x39,
+	0x3c, 0x39, 0x3f, 0x47, 0x3f, 0x0f, 0xcc, 0x4d, 0xce, 0x2f, 0x4a, 0xd5, 0xaf, 0xd0, 0x07, 0xf9,
+	0x0c, 0x6c, 0x70, 0x12, 0x1b, 0xd8, 0x67, 0xc6, 0x80, 0x01, 0x00, 0xa3, 0x7b, 0xa8, 0x1f, 0x1b,
+	0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +117,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -142,6 +159,8 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -213,6 +232,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Nolus-Protocol/nolus-core/app/params"
 	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,15 +22,103 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
-			genState: &types.GenesisState{Params: types.NewParams(types.DefaultFeeRate, types.DefaultContractAddress, types.DefaultBaseDenom)},
-			valid:    true,
+			desc: "valid genesis state",
+			genState: &types.GenesisState{Params: types.NewParams(types.DefaultFeeRate, types.DefaultContractAddress, types.DefaultBaseDenom,
+				types.DefaultMinGasPrice, types.DefaultFeeDenomMinGasPrices, types.DefaultMaxBaseFee, types.DefaultTargetBlockGas, types.DefaultBaseFeeChangeDenominator,
+				types.DefaultUnusedGasRefundRate, types.DefaultDisallowedMsgTypes, types.DefaultBypassMinFeeMsgTypes, types.DefaultMaxBypassMinFeeMsgGasUsage,
+				types.DefaultPriceFeeders, types.DefaultPriceFeedContracts)},
+			valid: true,
 		},
 		{
 			desc:     "invalid genesis state",
 			genState: &types.GenesisState{},
 			valid:    false,
 		},
+		{
+			desc: "valid genesis state with approved fee denoms",
+			genState: genesisWithParams(func(p *types.Params) {
+				p.FeeDenomMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0001")))
+			}),
+			valid: true,
+		},
+		{
+			desc:     "negative minimum gas price",
+			genState: genesisWithParams(func(p *types.Params) { p.MinGasPrice = sdk.NewDec(-1) }),
+			valid:    false,
+		},
+		{
+			desc: "approved fee denom with zero minimum gas price",
+			genState: genesisWithParams(func(p *types.Params) {
+				p.FeeDenomMinGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uatom", sdk.ZeroDec())}
+			}),
+			valid: false,
+		},
+		{
+			desc: "base denom as an approved fee denom",
+			genState: genesisWithParams(func(p *types.Params) {
+				p.FeeDenomMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.DefaultBaseDenom, sdk.OneDec()))
+			}),
+			valid: false,
+		},
+		{
+			desc:     "max base fee lower than the minimum gas price",
+			genState: genesisWithParams(func(p *types.Params) { p.MaxBaseFee = sdk.MustNewDecFromStr("0.001") }),
+			valid:    false,
+		},
+		{
+			desc:     "zero base fee change denominator",
+			genState: genesisWithParams(func(p *types.Params) { p.BaseFeeChangeDenominator = 0 }),
+			valid:    false,
+		},
+		{
+			desc:     "unused gas refund rate above one",
+			genState: genesisWithParams(func(p *types.Params) { p.UnusedGasRefundRate = sdk.MustNewDecFromStr("1.1") }),
+			valid:    false,
+		},
+		{
+			desc: "valid genesis state with disallowed message types",
+			genState: genesisWithParams(func(p *types.Params) {
+				p.DisallowedMsgTypes = []string{"/cosmwasm.wasm.v1.MsgStoreCode", "/ibc.applications.transfer.v1.MsgTransfer"}
+			}),
+			valid: true,
+		},
+		{
+			desc:     "invalid disallowed message type",
+			genState: genesisWithParams(func(p *types.Params) { p.DisallowedMsgTypes = []string{"MsgStoreCode"} }),
+			valid:    false,
+		},
+		{
+			desc: "duplicate disallowed message type",
+			genState: genesisWithParams(func(p *types.Params) {
+				p.DisallowedMsgTypes = []string{"/cosmwasm.wasm.v1.MsgStoreCode", "/cosmwasm.wasm.v1.MsgStoreCode"}
+			}),
+			valid: false,
+		},
+		{
+			desc:     "invalid bypass min fee message type",
+			genState: genesisWithParams(func(p *types.Params) { p.BypassMinFeeMsgTypes = []string{""} }),
+			valid:    false,
+		},
+		{
+			desc:     "invalid price feeder address",
+			genState: genesisWithParams(func(p *types.Params) { p.PriceFeeders = []string{"feeder"} }),
+			valid:    false,
+		},
+		{
+			desc: "duplicate price feed contract",
+			genState: genesisWithParams(func(p *types.Params) {
+				p.PriceFeedContracts = []string{types.DefaultContractAddress, types.DefaultContractAddress}
+			}),
+			valid: false,
+		},
+		{
+			desc: "negative base fee",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				BaseFee: sdk.NewDec(-1),
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -41,3 +130,10 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func genesisWithParams(modify func(p *types.Params)) *types.GenesisState {
+	params := types.DefaultParams()
+	modify(&params)
+
+	return types.NewGenesisState(params)
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "tax"
@@ -15,8 +19,31 @@ const (
 
 	// MemStoreKey defines the in-memory store key.
 	MemStoreKey = "mem_tax"
+
+	// TStoreKey defines the transient store key.
+	TStoreKey = "transient_tax"
+)
+
+var (
+	// BaseFeeKey is the store key of the base fee in force.
+	BaseFeeKey = []byte{0x02}
+
+	// BlockGasUsedKey is the store key of the gas used by the last block.
+	BlockGasUsedKey = []byte{0x03}
 )
 
+// TxFeePrefix is the transient store prefix under which the fees paid by the transactions
+// of the current block are kept, in the order of delivery, until the end of the block.
+var TxFeePrefix = []byte{0x04}
+
+// TxFeeCountKey is the transient store key of the number of fees kept in the current block.
+var TxFeeCountKey = []byte{0x05}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// TxFeeKey returns the transient store key of the fee paid by the transaction with the given sequence in the block.
+func TxFeeKey(seq uint64) []byte {
+	return append(TxFeePrefix, sdk.Uint64ToBigEndian(seq)...)
+}
@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"gopkg.in/yaml.v2"
 )
 
@@ -20,6 +22,47 @@ var (
 
 	KeyBaseDenom            = []byte("BaseDenom")
 	DefaultBaseDenom string = sdk.DefaultBondDenom
+
+	KeyMinGasPrice     = []byte("MinGasPrice")
+	DefaultMinGasPrice = sdk.MustNewDecFromStr("0.0025")
+
+	KeyFeeDenomMinGasPrices     = []byte("FeeDenomMinGasPrices")
+	DefaultFeeDenomMinGasPrices sdk.DecCoins
+
+	KeyMaxBaseFee     = []byte("MaxBaseFee")
+	DefaultMaxBaseFee = sdk.MustNewDecFromStr("0.25")
+
+	// The dynamic base fee is disabled by default, since there is no block gas limit to derive the target from
+	KeyTargetBlockGas            = []byte("TargetBlockGas")
+	DefaultTargetBlockGas uint64 = 0
+
+	KeyBaseFeeChangeDenominator            = []byte("BaseFeeChangeDenominator")
+	DefaultBaseFeeChangeDenominator uint32 = 8
+
+	KeyUnusedGasRefundRate     = []byte("UnusedGasRefundRate")
+	DefaultUnusedGasRefundRate = sdk.ZeroDec()
+
+	KeyDisallowedMsgTypes     = []byte("DisallowedMsgTypes")
+	DefaultDisallowedMsgTypes []string
+
+	// The IBC relayer messages are exempt from the minimum fee by default
+	KeyBypassMinFeeMsgTypes     = []byte("BypassMinFeeMsgTypes")
+	DefaultBypassMinFeeMsgTypes = []string{
+		sdk.MsgTypeURL(&ibcclienttypes.MsgUpdateClient{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgAcknowledgement{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeout{}),
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeoutOnClose{}),
+	}
+
+	KeyMaxBypassMinFeeMsgGasUsage            = []byte("MaxBypassMinFeeMsgGasUsage")
+	DefaultMaxBypassMinFeeMsgGasUsage uint64 = 1_000_000
+
+	KeyPriceFeeders     = []byte("PriceFeeders")
+	DefaultPriceFeeders []string
+
+	KeyPriceFeedContracts     = []byte("PriceFeedContracts")
+	DefaultPriceFeedContracts []string
 )
 
 // ParamKeyTable the param key table for launch module.
@@ -32,11 +75,33 @@ func NewParams(
 	feeRate int32,
 	contractAddress string,
 	baseDenom string,
+	minGasPrice sdk.Dec,
+	feeDenomMinGasPrices sdk.DecCoins,
+	maxBaseFee sdk.Dec,
+	targetBlockGas uint64,
+	baseFeeChangeDenominator uint32,
+	unusedGasRefundRate sdk.Dec,
+	disallowedMsgTypes []string,
+	bypassMinFeeMsgTypes []string,
+	maxBypassMinFeeMsgGasUsage uint64,
+	priceFeeders []string,
+	priceFeedContracts []string,
 ) Params {
 	return Params{
-		FeeRate:         feeRate,
-		ContractAddress: contractAddress,
-		BaseDenom:       baseDenom,
+		FeeRate:                    feeRate,
+		ContractAddress:            contractAddress,
+		BaseDenom:                  baseDenom,
+		MinGasPrice:                minGasPrice,
+		FeeDenomMinGasPrices:       feeDenomMinGasPrices,
+		MaxBaseFee:                 maxBaseFee,
+		TargetBlockGas:             targetBlockGas,
+		BaseFeeChangeDenominator:   baseFeeChangeDenominator,
+		UnusedGasRefundRate:        unusedGasRefundRate,
+		DisallowedMsgTypes:         disallowedMsgTypes,
+		BypassMinFeeMsgTypes:       bypassMinFeeMsgTypes,
+		MaxBypassMinFeeMsgGasUsage: maxBypassMinFeeMsgGasUsage,
+		PriceFeeders:               priceFeeders,
+		PriceFeedContracts:         priceFeedContracts,
 	}
 }
 
@@ -46,6 +111,17 @@ func DefaultParams() Params {
 		DefaultFeeRate,
 		DefaultContractAddress,
 		DefaultBaseDenom,
+		DefaultMinGasPrice,
+		DefaultFeeDenomMinGasPrices,
+		DefaultMaxBaseFee,
+		DefaultTargetBlockGas,
+		DefaultBaseFeeChangeDenominator,
+		DefaultUnusedGasRefundRate,
+		DefaultDisallowedMsgTypes,
+		DefaultBypassMinFeeMsgTypes,
+		DefaultMaxBypassMinFeeMsgGasUsage,
+		DefaultPriceFeeders,
+		DefaultPriceFeedContracts,
 	)
 }
 
@@ -55,6 +131,17 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyFeeRate, &p.FeeRate, validateFeeRate),
 		paramtypes.NewParamSetPair(KeyContractAddress, &p.ContractAddress, validateContractAddress),
 		paramtypes.NewParamSetPair(KeyBaseDenom, &p.BaseDenom, validateBaseDenom),
+		paramtypes.NewParamSetPair(KeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
+		paramtypes.NewParamSetPair(KeyFeeDenomMinGasPrices, &p.FeeDenomMinGasPrices, validateFeeDenomMinGasPrices),
+		paramtypes.NewParamSetPair(KeyMaxBaseFee, &p.MaxBaseFee, validateMaxBaseFee),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyUnusedGasRefundRate, &p.UnusedGasRefundRate, validateUnusedGasRefundRate),
+		paramtypes.NewParamSetPair(KeyDisallowedMsgTypes, &p.DisallowedMsgTypes, validateDisallowedMsgTypes),
+		paramtypes.NewParamSetPair(KeyBypassMinFeeMsgTypes, &p.BypassMinFeeMsgTypes, validateBypassMinFeeMsgTypes),
+		paramtypes.NewParamSetPair(KeyMaxBypassMinFeeMsgGasUsage, &p.MaxBypassMinFeeMsgGasUsage, validateMaxBypassMinFeeMsgGasUsage),
+		paramtypes.NewParamSetPair(KeyPriceFeeders, &p.PriceFeeders, validatePriceFeeders),
+		paramtypes.NewParamSetPair(KeyPriceFeedContracts, &p.PriceFeedContracts, validatePriceFeedContracts),
 	}
 }
 
@@ -72,6 +159,58 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateFeeDenomMinGasPrices(p.FeeDenomMinGasPrices); err != nil {
+		return err
+	}
+
+	if p.FeeDenomMinGasPrices.AmountOf(p.BaseDenom).IsPositive() {
+		return fmt.Errorf("base denom %s can not be an additional fee denom", p.BaseDenom)
+	}
+
+	if err := validateMaxBaseFee(p.MaxBaseFee); err != nil {
+		return err
+	}
+
+	if p.MaxBaseFee.LT(p.MinGasPrice) {
+		return sdkerrors.Wrapf(ErrInvalidBaseFee, "max base fee %s is lower than the min gas price %s", p.MaxBaseFee, p.MinGasPrice)
+	}
+
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+
+	if err := validateUnusedGasRefundRate(p.UnusedGasRefundRate); err != nil {
+		return err
+	}
+
+	if err := validateDisallowedMsgTypes(p.DisallowedMsgTypes); err != nil {
+		return err
+	}
+
+	if err := validateBypassMinFeeMsgTypes(p.BypassMinFeeMsgTypes); err != nil {
+		return err
+	}
+
+	if err := validateMaxBypassMinFeeMsgGasUsage(p.MaxBypassMinFeeMsgGasUsage); err != nil {
+		return err
+	}
+
+	if err := validatePriceFeeders(p.PriceFeeders); err != nil {
+		return err
+	}
+
+	if err := validatePriceFeedContracts(p.PriceFeedContracts); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -125,3 +264,202 @@ func validateBaseDenom(v interface{}) error {
 
 	return nil
 }
+
+func validateMinGasPrice(v interface{}) error {
+	minGasPrice, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if minGasPrice.IsNil() || minGasPrice.IsNegative() {
+		return ErrInvalidMinGasPrice
+	}
+
+	return nil
+}
+
+func validateFeeDenomMinGasPrices(v interface{}) error {
+	minGasPrices, ok := v.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// every approved fee denom must have a positive floor, sorted and without duplicates
+	if err := minGasPrices.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMinGasPrice, err.Error())
+	}
+
+	return nil
+}
+
+func validateMaxBaseFee(v interface{}) error {
+	maxBaseFee, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxBaseFee.IsNil() || maxBaseFee.IsNegative() {
+		return ErrInvalidBaseFee
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(v interface{}) error {
+	denominator, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if denominator == 0 {
+		return errors.New("base fee change denominator cannot be zero")
+	}
+
+	return nil
+}
+
+func validateUnusedGasRefundRate(v interface{}) error {
+	refundRate, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if refundRate.IsNil() || refundRate.IsNegative() || refundRate.GT(sdk.OneDec()) {
+		return ErrInvalidRefundRate
+	}
+
+	return nil
+}
+
+func validateDisallowedMsgTypes(v interface{}) error {
+	msgTypes, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return validateMsgTypeURLs(msgTypes)
+}
+
+func validateBypassMinFeeMsgTypes(v interface{}) error {
+	msgTypes, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return validateMsgTypeURLs(msgTypes)
+}
+
+func validateMaxBypassMinFeeMsgGasUsage(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateMsgTypeURLs(msgTypes []string) error {
+	seen := make(map[string]bool, len(msgTypes))
+	for _, msgType := range msgTypes {
+		if !strings.HasPrefix(msgType, "/") || strings.TrimSpace(msgType) != msgType {
+			return fmt.Errorf("invalid message type URL: %q", msgType)
+		}
+
+		if seen[msgType] {
+			return fmt.Errorf("duplicate message type URL: %s", msgType)
+		}
+		seen[msgType] = true
+	}
+
+	return nil
+}
+
+func validatePriceFeeders(v interface{}) error {
+	feeders, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return validateAddresses(feeders)
+}
+
+func validatePriceFeedContracts(v interface{}) error {
+	contracts, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return validateAddresses(contracts)
+}
+
+func validateAddresses(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrap(ErrInvalidAddress, err.Error())
+		}
+
+		if seen[address] {
+			return fmt.Errorf("duplicate address: %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// ContainsMsgType returns whether the list of message type URLs contains the given one.
+func ContainsMsgType(msgTypes []string, msgTypeURL string) bool {
+	for _, msgType := range msgTypes {
+		if msgType == msgTypeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsAddress returns whether the list of bech32 addresses contains the given one.
+func ContainsAddress(addresses []string, address string) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextBaseFee calculates the base fee of the following block from the base fee and the gas used by the current block.
+// The base fee changes by at most 1/BaseFeeChangeDenominator proportionally to the deviation of the gas used
+// from the target, and it is kept within [MinGasPrice, MaxBaseFee]. A block above the target raises the base fee
+// by at least the smallest decimal, so that it may rise from a zero MinGasPrice.
+func (p Params) NextBaseFee(baseFee sdk.Dec, gasUsed uint64) sdk.Dec {
+	if p.TargetBlockGas == 0 {
+		return p.BoundBaseFee(p.MinGasPrice)
+	}
+
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(p.TargetBlockGas))
+	used := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed))
+	deviation := sdk.MinDec(sdk.MaxDec(used.Sub(target).Quo(target), sdk.OneDec().Neg()), sdk.OneDec())
+	delta := baseFee.Mul(deviation).QuoInt64(int64(p.BaseFeeChangeDenominator))
+	if deviation.IsPositive() && !delta.IsPositive() {
+		delta = sdk.SmallestDec()
+	}
+
+	return p.BoundBaseFee(baseFee.Add(delta))
+}
+
+// BoundBaseFee returns the base fee kept within [MinGasPrice, MaxBaseFee].
+func (p Params) BoundBaseFee(baseFee sdk.Dec) sdk.Dec {
+	return sdk.MinDec(sdk.MaxDec(baseFee, p.MinGasPrice), p.MaxBaseFee)
+}
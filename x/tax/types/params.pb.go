@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -28,6 +30,36 @@ type Params struct {
 	FeeRate         int32  `protobuf:"varint,1,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	BaseDenom       string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// min_gas_price is the minimum gas price in base denom accepted by the network.
+	// It is enforced in both CheckTx and DeliverTx.
+	MinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_price"`
+	// fee_denom_min_gas_prices are the governance approved denoms, other than the base denom,
+	// in which fees can be paid, together with their minimum gas price.
+	FeeDenomMinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=fee_denom_min_gas_prices,json=feeDenomMinGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_denom_min_gas_prices"`
+	// max_base_fee is the upper bound of the base fee. The lower bound is min_gas_price.
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee"`
+	// target_block_gas is the gas used per block at which the base fee stays unchanged.
+	// The base fee increases when a block uses more gas and decreases when it uses less.
+	// Zero disables the dynamic base fee, so only min_gas_price applies.
+	TargetBlockGas uint64 `protobuf:"varint,7,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the base fee change between two blocks to 1/denominator.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,8,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// unused_gas_refund_rate is the fraction of the fee paid for the unused gas of a transaction
+	// that is refunded to the fee payer at the end of the block. Zero disables the refund.
+	UnusedGasRefundRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=unused_gas_refund_rate,json=unusedGasRefundRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unused_gas_refund_rate"`
+	// disallowed_msg_types are the type URLs of the messages rejected by the network,
+	// either at the top level of a transaction or nested in an authz MsgExec.
+	DisallowedMsgTypes []string `protobuf:"bytes,10,rep,name=disallowed_msg_types,json=disallowedMsgTypes,proto3" json:"disallowed_msg_types,omitempty"`
+	// bypass_min_fee_msg_types are the type URLs of the messages, typically the IBC relayer ones,
+	// that are exempt from the minimum fee if a transaction contains only such messages.
+	BypassMinFeeMsgTypes []string `protobuf:"bytes,11,rep,name=bypass_min_fee_msg_types,json=bypassMinFeeMsgTypes,proto3" json:"bypass_min_fee_msg_types,omitempty"`
+	// max_bypass_min_fee_msg_gas_usage is the max gas limit of a transaction exempt from the minimum fee.
+	MaxBypassMinFeeMsgGasUsage uint64 `protobuf:"varint,12,opt,name=max_bypass_min_fee_msg_gas_usage,json=maxBypassMinFeeMsgGasUsage,proto3" json:"max_bypass_min_fee_msg_gas_usage,omitempty"`
+	// price_feeders are the addresses of the oracle price feeders. Their transactions executing only
+	// price_feed_contracts get the highest priority in the mempool, ahead of the fee based priority.
+	PriceFeeders []string `protobuf:"bytes,13,rep,name=price_feeders,json=priceFeeders,proto3" json:"price_feeders,omitempty"`
+	// price_feed_contracts are the addresses of the oracle contracts fed by the price_feeders.
+	PriceFeedContracts []string `protobuf:"bytes,14,rep,name=price_feed_contracts,json=priceFeedContracts,proto3" json:"price_feed_contracts,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -83,6 +115,62 @@ func (m *Params) GetBaseDenom() string {
 	return ""
 }
 
+func (m *Params) GetFeeDenomMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeDenomMinGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetDisallowedMsgTypes() []string {
+	if m != nil {
+		return m.DisallowedMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetBypassMinFeeMsgTypes() []string {
+	if m != nil {
+		return m.BypassMinFeeMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBypassMinFeeMsgGasUsage() uint64 {
+	if m != nil {
+		return m.MaxBypassMinFeeMsgGasUsage
+	}
+	return 0
+}
+
+func (m *Params) GetPriceFeeders() []string {
+	if m != nil {
+		return m.PriceFeeders
+	}
+	return nil
+}
+
+func (m *Params) GetPriceFeedContracts() []string {
+	if m != nil {
+		return m.PriceFeedContracts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "tax.Params")
 }
@@ -90,22 +178,45 @@ func init() {
 func init() { proto.RegisterFile("tax/params.proto", fileDescriptor_b5ff4cb1b83fd8f3) }
 
 var fileDescriptor_b5ff4cb1b83fd8f3 = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x28, 0x49, 0xac, 0xd0,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2e, 0x49,
-	0xac, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xf3, 0xf5, 0x41, 0x2c, 0x88, 0x94, 0x52, 0x31,
-	0x17, 0x5b, 0x00, 0x58, 0xa9, 0x90, 0x24, 0x17, 0x47, 0x5a, 0x6a, 0x6a, 0x7c, 0x51, 0x62, 0x49,
-	0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x7b, 0x5a, 0x6a, 0x6a, 0x50, 0x62, 0x49, 0xaa,
-	0x90, 0x26, 0x97, 0x40, 0x72, 0x7e, 0x5e, 0x49, 0x51, 0x62, 0x72, 0x49, 0x7c, 0x62, 0x4a, 0x4a,
-	0x51, 0x6a, 0x71, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x3f, 0x4c, 0xdc, 0x11, 0x22,
-	0x2c, 0x24, 0xcb, 0xc5, 0x95, 0x94, 0x58, 0x9c, 0x1a, 0x9f, 0x92, 0x9a, 0x97, 0x9f, 0x2b, 0xc1,
-	0x0c, 0x56, 0xc4, 0x09, 0x12, 0x71, 0x01, 0x09, 0x58, 0xb1, 0xcc, 0x58, 0x20, 0xcf, 0xe0, 0xe4,
-	0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x7e, 0xf9, 0x39, 0xa5, 0xc5, 0xba, 0x01, 0x20, 0x67,
-	0x26, 0xe7, 0xe7, 0xe8, 0xe7, 0x81, 0xb9, 0xc9, 0xf9, 0x45, 0xa9, 0xfa, 0x15, 0xfa, 0x20, 0x2f,
-	0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xfd, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0xce, 0xce, 0xae, 0x09, 0xf6, 0x00, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6f, 0xd3, 0x3e,
+	0x14, 0x6e, 0x7e, 0xdd, 0xba, 0xd5, 0x5b, 0xf7, 0x9b, 0x4c, 0x85, 0xcc, 0x80, 0x34, 0x02, 0x09,
+	0x05, 0xa1, 0x25, 0x1b, 0x93, 0x38, 0x20, 0x71, 0xa0, 0xab, 0x56, 0x09, 0x69, 0xa8, 0x8a, 0xe0,
+	0xc2, 0x25, 0x72, 0x92, 0xd7, 0x2c, 0x5a, 0x63, 0x57, 0x79, 0x2e, 0x74, 0x7f, 0x02, 0x37, 0x8e,
+	0x1c, 0x39, 0xf3, 0x97, 0xec, 0xb8, 0x23, 0xe2, 0x30, 0xd0, 0xf6, 0x5f, 0x70, 0x42, 0xb6, 0xdb,
+	0x95, 0x89, 0x0b, 0xda, 0xa9, 0xf5, 0xf7, 0xde, 0xf7, 0x3d, 0x7f, 0x9f, 0xed, 0x90, 0x4d, 0xc5,
+	0xa7, 0xe1, 0x98, 0x57, 0xbc, 0xc4, 0x60, 0x5c, 0x49, 0x25, 0x69, 0x5d, 0xf1, 0xe9, 0x56, 0x3b,
+	0x97, 0xb9, 0x34, 0xeb, 0x50, 0xff, 0xb3, 0xa5, 0x2d, 0x37, 0x95, 0x58, 0x4a, 0x0c, 0x13, 0x8e,
+	0x10, 0xbe, 0xdf, 0x4d, 0x40, 0xf1, 0xdd, 0x30, 0x95, 0x85, 0xb0, 0xf5, 0x07, 0xbf, 0x1a, 0xa4,
+	0x31, 0x30, 0x5a, 0xf4, 0x0e, 0x59, 0x1d, 0x02, 0xc4, 0x15, 0x57, 0xc0, 0x1c, 0xcf, 0xf1, 0x97,
+	0xa3, 0x95, 0x21, 0x40, 0xc4, 0x15, 0xd0, 0xc7, 0x64, 0x33, 0x95, 0x42, 0x55, 0x3c, 0x55, 0x31,
+	0xcf, 0xb2, 0x0a, 0x10, 0xd9, 0x7f, 0x9e, 0xe3, 0x37, 0xa3, 0xff, 0xe7, 0xf8, 0x4b, 0x0b, 0xd3,
+	0xfb, 0x84, 0xe8, 0x59, 0x71, 0x06, 0x42, 0x96, 0xac, 0x6e, 0x9a, 0x9a, 0x1a, 0xe9, 0x69, 0x80,
+	0x46, 0xa4, 0x55, 0x16, 0x22, 0xce, 0x39, 0xc6, 0xe3, 0xaa, 0x48, 0x81, 0x2d, 0xe9, 0x8e, 0x6e,
+	0x70, 0x7a, 0xde, 0xa9, 0x7d, 0x3f, 0xef, 0x3c, 0xca, 0x0b, 0x75, 0x34, 0x49, 0x82, 0x54, 0x96,
+	0xe1, 0x6c, 0xe7, 0xf6, 0x67, 0x1b, 0xb3, 0xe3, 0x50, 0x9d, 0x8c, 0x01, 0x83, 0x1e, 0xa4, 0xd1,
+	0x5a, 0x59, 0x88, 0x3e, 0xc7, 0x81, 0x96, 0xa0, 0x1f, 0x1d, 0xc2, 0x86, 0x30, 0x1b, 0x19, 0x5f,
+	0x93, 0x47, 0xb6, 0xec, 0xd5, 0xfd, 0xb5, 0xa7, 0xf7, 0x02, 0x2b, 0x13, 0xe8, 0x9d, 0x04, 0xb3,
+	0x1c, 0xb4, 0xd2, 0xbe, 0x2c, 0x44, 0x77, 0x4f, 0x4f, 0xff, 0xfa, 0xa3, 0xf3, 0xe4, 0xdf, 0xa6,
+	0x6b, 0x0e, 0x46, 0xed, 0x21, 0x58, 0x4f, 0x87, 0x8b, 0xad, 0x20, 0x1d, 0x90, 0xf5, 0x92, 0x4f,
+	0x63, 0x13, 0xc1, 0x10, 0x80, 0x35, 0x6e, 0x64, 0x8f, 0x94, 0x7c, 0xda, 0xe5, 0x08, 0x07, 0x00,
+	0xd4, 0xd7, 0x07, 0x5e, 0xe5, 0xa0, 0xe2, 0x64, 0x24, 0xd3, 0x63, 0xed, 0x8d, 0xad, 0x78, 0x8e,
+	0xbf, 0x14, 0x6d, 0x58, 0xbc, 0xab, 0xe1, 0x3e, 0x47, 0xfa, 0x82, 0xdc, 0x9d, 0xcf, 0x8d, 0xd3,
+	0x23, 0x2e, 0xf2, 0x59, 0x24, 0x85, 0xe0, 0x4a, 0x56, 0x6c, 0xd5, 0x73, 0xfc, 0x56, 0xc4, 0x12,
+	0xab, 0xbb, 0x6f, 0x1a, 0x7a, 0x8b, 0x3a, 0x4d, 0xc9, 0xed, 0x89, 0x98, 0x20, 0x64, 0x26, 0xbe,
+	0x0a, 0x86, 0x13, 0x91, 0xd9, 0xdb, 0xd0, 0xbc, 0x91, 0x89, 0x5b, 0x56, 0xad, 0xcf, 0x31, 0x32,
+	0x5a, 0xe6, 0x26, 0xed, 0x90, 0x76, 0x56, 0x20, 0x1f, 0x8d, 0xe4, 0x07, 0xc8, 0xe2, 0x12, 0xf3,
+	0xd8, 0x10, 0x18, 0xf1, 0xea, 0x7e, 0x33, 0xa2, 0x8b, 0xda, 0x21, 0xe6, 0x6f, 0x74, 0x85, 0x3e,
+	0x23, 0x2c, 0x39, 0x19, 0x73, 0x44, 0x73, 0xb2, 0xda, 0xdb, 0x82, 0xb5, 0x66, 0x58, 0x6d, 0x5b,
+	0x3f, 0x2c, 0xc4, 0x01, 0xc0, 0x15, 0xaf, 0x47, 0x3c, 0x73, 0x12, 0x7f, 0x73, 0xb5, 0xbd, 0x09,
+	0xf2, 0x1c, 0xd8, 0xba, 0xc9, 0x71, 0x4b, 0xa7, 0x7d, 0x5d, 0xa2, 0xcf, 0xf1, 0xad, 0xee, 0xa0,
+	0x0f, 0x49, 0xcb, 0x5c, 0x24, 0x4d, 0xce, 0xa0, 0x42, 0xd6, 0x32, 0x23, 0xd7, 0x0d, 0x78, 0x60,
+	0x31, 0x6d, 0x6a, 0xd1, 0x14, 0xcf, 0x5f, 0x04, 0xb2, 0x0d, 0x6b, 0xea, 0xaa, 0x77, 0x7f, 0x5e,
+	0x79, 0xbe, 0xf4, 0xf9, 0x4b, 0xa7, 0xd6, 0x7d, 0x75, 0x7a, 0xe1, 0x3a, 0x67, 0x17, 0xae, 0xf3,
+	0xf3, 0xc2, 0x75, 0x3e, 0x5d, 0xba, 0xb5, 0xb3, 0x4b, 0xb7, 0xf6, 0xed, 0xd2, 0xad, 0xbd, 0xdb,
+	0xf9, 0x23, 0xe3, 0xd7, 0x72, 0x34, 0xc1, 0xed, 0x81, 0x7e, 0xae, 0xa9, 0x1c, 0x85, 0xc2, 0x2c,
+	0x53, 0x59, 0x41, 0x38, 0x0d, 0xf5, 0xa7, 0xc0, 0x44, 0x91, 0x34, 0xcc, 0x7b, 0xde, 0xfb, 0x3d,
+	0x00, 0xcb, 0x73, 0xdb, 0x86, 0x1e, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -128,6 +239,101 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceFeedContracts) > 0 {
+		for iNdEx := len(m.PriceFeedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriceFeedContracts[iNdEx])
+			copy(dAtA[i:], m.PriceFeedContracts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PriceFeedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PriceFeeders) > 0 {
+		for iNdEx := len(m.PriceFeeders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriceFeeders[iNdEx])
+			copy(dAtA[i:], m.PriceFeeders[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PriceFeeders[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.MaxBypassMinFeeMsgGasUsage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBypassMinFeeMsgGasUsage))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMinFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMinFeeMsgTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BypassMinFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DisallowedMsgTypes) > 0 {
+		for iNdEx := len(m.DisallowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisallowedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.DisallowedMsgTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DisallowedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.UnusedGasRefundRate.Size()
+		i -= size
+		if _, err := m.UnusedGasRefundRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.FeeDenomMinGasPrices) > 0 {
+		for iNdEx := len(m.FeeDenomMinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomMinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.BaseDenom) > 0 {
 		i -= len(m.BaseDenom)
 		copy(dAtA[i:], m.BaseDenom)
@@ -178,6 +384,51 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.FeeDenomMinGasPrices) > 0 {
+		for _, e := range m.FeeDenomMinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovParams(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovParams(uint64(m.BaseFeeChangeDenominator))
+	}
+	l = m.UnusedGasRefundRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.DisallowedMsgTypes) > 0 {
+		for _, s := range m.DisallowedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovParams(uint64(m.MaxBypassMinFeeMsgGasUsage))
+	}
+	if len(m.PriceFeeders) > 0 {
+		for _, s := range m.PriceFeeders {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.PriceFeedContracts) > 0 {
+		for _, s := range m.PriceFeedContracts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -299,6 +550,327 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomMinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomMinGasPrices = append(m.FeeDenomMinGasPrices, types.DecCoin{})
+			if err := m.FeeDenomMinGasPrices[len(m.FeeDenomMinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnusedGasRefundRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnusedGasRefundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisallowedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisallowedMsgTypes = append(m.DisallowedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBypassMinFeeMsgGasUsage", wireType)
+			}
+			m.MaxBypassMinFeeMsgGasUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBypassMinFeeMsgGasUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeeders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeeders = append(m.PriceFeeders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeedContracts = append(m.PriceFeedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinGasPrice = sdk.MustNewDecFromStr("0.0025")
+	params.MaxBaseFee = sdk.MustNewDecFromStr("0.01")
+	params.TargetBlockGas = 1_000_000
+	params.BaseFeeChangeDenominator = 8
+
+	for _, tc := range []struct {
+		desc    string
+		params  types.Params
+		baseFee sdk.Dec
+		gasUsed uint64
+		expNext sdk.Dec
+	}{
+		{
+			desc:    "gas used at target keeps the base fee",
+			params:  params,
+			baseFee: sdk.MustNewDecFromStr("0.004"),
+			gasUsed: 1_000_000,
+			expNext: sdk.MustNewDecFromStr("0.004"),
+		},
+		{
+			desc:    "double the target increases the base fee by 1/8",
+			params:  params,
+			baseFee: sdk.MustNewDecFromStr("0.004"),
+			gasUsed: 2_000_000,
+			expNext: sdk.MustNewDecFromStr("0.0045"),
+		},
+		{
+			desc:    "ten times the target increases the base fee by at most 1/8",
+			params:  params,
+			baseFee: sdk.MustNewDecFromStr("0.004"),
+			gasUsed: 10_000_000,
+			expNext: sdk.MustNewDecFromStr("0.0045"),
+		},
+		{
+			desc: "base fee rises from a zero minimum gas price",
+			params: func() types.Params {
+				p := params
+				p.MinGasPrice = sdk.ZeroDec()
+				return p
+			}(),
+			baseFee: sdk.ZeroDec(),
+			gasUsed: 2_000_000,
+			expNext: sdk.SmallestDec(),
+		},
+		{
+			desc:    "empty block decreases the base fee by 1/8",
+			params:  params,
+			baseFee: sdk.MustNewDecFromStr("0.004"),
+			gasUsed: 0,
+			expNext: sdk.MustNewDecFromStr("0.0035"),
+		},
+		{
+			desc:    "base fee does not drop below the minimum gas price",
+			params:  params,
+			baseFee: sdk.MustNewDecFromStr("0.0026"),
+			gasUsed: 0,
+			expNext: sdk.MustNewDecFromStr("0.0025"),
+		},
+		{
+			desc:    "base fee does not exceed the max base fee",
+			params:  params,
+			baseFee: sdk.MustNewDecFromStr("0.0099"),
+			gasUsed: 5_000_000,
+			expNext: sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			desc: "zero target disables the dynamic base fee",
+			params: func() types.Params {
+				p := params
+				p.TargetBlockGas = 0
+				return p
+			}(),
+			baseFee: sdk.MustNewDecFromStr("0.004"),
+			gasUsed: 5_000_000,
+			expNext: sdk.MustNewDecFromStr("0.0025"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expNext, tc.params.NextBaseFee(tc.baseFee, tc.gasUsed))
+		})
+	}
+}
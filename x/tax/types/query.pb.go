@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,32 +114,302 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryMinGasPricesRequest is request type for the Query/MinGasPrices RPC method.
+type QueryMinGasPricesRequest struct {
+}
+
+func (m *QueryMinGasPricesRequest) Reset()         { *m = QueryMinGasPricesRequest{} }
+func (m *QueryMinGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPricesRequest) ProtoMessage()    {}
+func (*QueryMinGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7620848389f966a, []int{2}
+}
+func (m *QueryMinGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPricesRequest.Merge(m, src)
+}
+func (m *QueryMinGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinGasPricesRequest proto.InternalMessageInfo
+
+// QueryMinGasPricesResponse is response type for the Query/MinGasPrices RPC method.
+type QueryMinGasPricesResponse struct {
+	// min_gas_prices holds the minimum gas price of the base denom and of every approved fee denom.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
+}
+
+func (m *QueryMinGasPricesResponse) Reset()         { *m = QueryMinGasPricesResponse{} }
+func (m *QueryMinGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPricesResponse) ProtoMessage()    {}
+func (*QueryMinGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7620848389f966a, []int{3}
+}
+func (m *QueryMinGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPricesResponse.Merge(m, src)
+}
+func (m *QueryMinGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryMinGasPricesResponse) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+// QueryBaseFeeRequest is request type for the Query/BaseFee RPC method.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7620848389f966a, []int{4}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse is response type for the Query/BaseFee RPC method.
+type QueryBaseFeeResponse struct {
+	// base_fee is the minimum gas price in base denom of the transactions in the upcoming block.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee"`
+	// next_base_fee is the base fee of the following block if the upcoming block uses as much gas as the last one.
+	NextBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=next_base_fee,json=nextBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"next_base_fee"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7620848389f966a, []int{5}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+// QueryDisallowedMsgTypesRequest is request type for the Query/DisallowedMsgTypes RPC method.
+type QueryDisallowedMsgTypesRequest struct {
+}
+
+func (m *QueryDisallowedMsgTypesRequest) Reset()         { *m = QueryDisallowedMsgTypesRequest{} }
+func (m *QueryDisallowedMsgTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisallowedMsgTypesRequest) ProtoMessage()    {}
+func (*QueryDisallowedMsgTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7620848389f966a, []int{6}
+}
+func (m *QueryDisallowedMsgTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisallowedMsgTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisallowedMsgTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisallowedMsgTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisallowedMsgTypesRequest.Merge(m, src)
+}
+func (m *QueryDisallowedMsgTypesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisallowedMsgTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisallowedMsgTypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisallowedMsgTypesRequest proto.InternalMessageInfo
+
+// QueryDisallowedMsgTypesResponse is response type for the Query/DisallowedMsgTypes RPC method.
+type QueryDisallowedMsgTypesResponse struct {
+	// msg_type_urls holds the type URLs of the messages rejected by the network.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *QueryDisallowedMsgTypesResponse) Reset()         { *m = QueryDisallowedMsgTypesResponse{} }
+func (m *QueryDisallowedMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisallowedMsgTypesResponse) ProtoMessage()    {}
+func (*QueryDisallowedMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7620848389f966a, []int{7}
+}
+func (m *QueryDisallowedMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisallowedMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisallowedMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisallowedMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisallowedMsgTypesResponse.Merge(m, src)
+}
+func (m *QueryDisallowedMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisallowedMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisallowedMsgTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisallowedMsgTypesResponse proto.InternalMessageInfo
+
+func (m *QueryDisallowedMsgTypesResponse) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "tax.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "tax.QueryParamsResponse")
+	proto.RegisterType((*QueryMinGasPricesRequest)(nil), "tax.QueryMinGasPricesRequest")
+	proto.RegisterType((*QueryMinGasPricesResponse)(nil), "tax.QueryMinGasPricesResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "tax.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "tax.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryDisallowedMsgTypesRequest)(nil), "tax.QueryDisallowedMsgTypesRequest")
+	proto.RegisterType((*QueryDisallowedMsgTypesResponse)(nil), "tax.QueryDisallowedMsgTypesResponse")
 }
 
 func init() { proto.RegisterFile("tax/query.proto", fileDescriptor_c7620848389f966a) }
 
 var fileDescriptor_c7620848389f966a = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2f, 0x49, 0xac, 0xd0,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2e, 0x49, 0xac,
-	0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xf3, 0xf5, 0x41, 0x2c, 0x88, 0x94, 0x94, 0x4c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62,
-	0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x00, 0x64, 0x52, 0x41, 0x62, 0x51, 0x62, 0x2e, 0x54,
-	0x44, 0x49, 0x84, 0x4b, 0x28, 0x10, 0x64, 0x72, 0x00, 0x58, 0x30, 0x28, 0xb5, 0xb0, 0x34, 0xb5,
-	0xb8, 0x44, 0xc9, 0x81, 0x4b, 0x18, 0x45, 0xb4, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x48, 0x93,
-	0x8b, 0x0d, 0xa2, 0x59, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x88, 0x5b, 0xaf, 0x24, 0xb1, 0x42,
-	0x0f, 0xa2, 0xc8, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x02, 0xa3, 0x4c, 0x2e, 0x56,
-	0xb0, 0x09, 0x42, 0x09, 0x5c, 0x6c, 0x10, 0x05, 0x42, 0xe2, 0x60, 0xd5, 0x98, 0xb6, 0x49, 0x49,
-	0x60, 0x4a, 0x40, 0x2c, 0x54, 0x52, 0x6e, 0xba, 0xfc, 0x64, 0x32, 0x93, 0xac, 0x90, 0xb4, 0x7e,
-	0x5e, 0x7e, 0x6e, 0xbe, 0x7e, 0x5e, 0x7e, 0x4e, 0x69, 0xb1, 0x6e, 0x72, 0x7e, 0x51, 0xaa, 0x3e,
-	0xc2, 0x23, 0x4e, 0x5e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x90,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x07, 0xd6, 0x1b, 0x00, 0xf2,
-	0x74, 0x72, 0x7e, 0x0e, 0xb2, 0x51, 0x15, 0x60, 0xc3, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0xa1, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xab, 0xa6, 0x94, 0x37, 0x73, 0x01, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0xef, 0x4b, 0xe9, 0xa4, 0x05, 0x34, 0x04, 0x91, 0x9a, 0xc6, 0x89, 0x1c, 0x8a,
+	0x5a, 0x45, 0xf5, 0x90, 0xf4, 0x05, 0x50, 0x28, 0x20, 0x90, 0x8a, 0x82, 0x05, 0x1b, 0x36, 0x66,
+	0xe2, 0x0c, 0xc6, 0xc2, 0xf6, 0xb8, 0x9e, 0x09, 0x4d, 0x59, 0xf2, 0x04, 0x08, 0x78, 0x0a, 0xf6,
+	0xbc, 0x43, 0x97, 0x95, 0xd8, 0x20, 0x16, 0x05, 0x12, 0x1e, 0x04, 0xcd, 0x78, 0xf2, 0xa7, 0x38,
+	0x08, 0xb1, 0x4a, 0x3c, 0xe7, 0xde, 0x73, 0xce, 0x9d, 0x7b, 0x34, 0xe0, 0x12, 0xc7, 0x03, 0x74,
+	0xd4, 0x27, 0xc9, 0x89, 0x15, 0x27, 0x94, 0x53, 0x98, 0xe7, 0x78, 0xa0, 0x97, 0x3c, 0xea, 0x51,
+	0xf9, 0x8d, 0xc4, 0xbf, 0x14, 0xd2, 0xb7, 0x3c, 0x4a, 0xbd, 0x80, 0x20, 0x1c, 0xfb, 0x08, 0x47,
+	0x11, 0xe5, 0x98, 0xfb, 0x34, 0x62, 0x0a, 0x35, 0x5c, 0xca, 0x42, 0xca, 0x50, 0x17, 0x33, 0x82,
+	0x5e, 0x37, 0xbb, 0x84, 0xe3, 0x26, 0x72, 0xa9, 0x1f, 0x29, 0xfc, 0xb2, 0x50, 0x8a, 0x71, 0x82,
+	0x43, 0xd5, 0x61, 0x96, 0x00, 0x7c, 0x2c, 0x94, 0x3b, 0xf2, 0xd0, 0x26, 0x47, 0x7d, 0xc2, 0xb8,
+	0x79, 0x1b, 0x5c, 0x99, 0x3b, 0x65, 0x31, 0x8d, 0x18, 0x81, 0xbb, 0xa0, 0x90, 0x36, 0x97, 0xb5,
+	0x9a, 0xb6, 0x53, 0x6c, 0x15, 0x2d, 0x8e, 0x07, 0x56, 0x5a, 0xd4, 0xfe, 0xef, 0xf4, 0xbc, 0x9a,
+	0xb3, 0x55, 0x81, 0xa9, 0x83, 0xb2, 0x64, 0x38, 0xf4, 0xa3, 0xfb, 0x98, 0x75, 0x12, 0xdf, 0x25,
+	0x13, 0xf6, 0x8f, 0x1a, 0xd8, 0xcc, 0x00, 0x95, 0xc8, 0x31, 0xb8, 0x18, 0xfa, 0x91, 0xe3, 0x61,
+	0xe6, 0xc4, 0x12, 0x29, 0x6b, 0xb5, 0xfc, 0x4e, 0xb1, 0xb5, 0x65, 0xa5, 0xc3, 0x59, 0x62, 0x38,
+	0x4b, 0x0d, 0x67, 0x1d, 0x10, 0xf7, 0x0e, 0xf5, 0xa3, 0xf6, 0xbe, 0x50, 0xff, 0xf4, 0xbd, 0xda,
+	0xf0, 0x7c, 0xfe, 0xb2, 0xdf, 0xb5, 0x5c, 0x1a, 0x22, 0x75, 0x19, 0xe9, 0xcf, 0x1e, 0xeb, 0xbd,
+	0x42, 0xfc, 0x24, 0x26, 0x6c, 0xdc, 0xc3, 0xec, 0xf5, 0x70, 0xc6, 0x80, 0x79, 0x55, 0x0d, 0xdd,
+	0xc6, 0x8c, 0xdc, 0x23, 0x64, 0xec, 0xf6, 0xb3, 0x06, 0x4a, 0xf3, 0xe7, 0xca, 0xe8, 0x03, 0x70,
+	0x41, 0x58, 0x71, 0x5e, 0x10, 0x22, 0xef, 0x63, 0xad, 0x6d, 0x09, 0x13, 0xdf, 0xce, 0xab, 0x37,
+	0xff, 0xce, 0x84, 0xbd, 0xda, 0x4d, 0x29, 0xa1, 0x0d, 0x36, 0x22, 0x32, 0xe0, 0xce, 0x84, 0x6f,
+	0xe5, 0x9f, 0xf8, 0x8a, 0x82, 0x44, 0xd9, 0x34, 0x6b, 0xc0, 0x90, 0xb6, 0x0f, 0x7c, 0x86, 0x83,
+	0x80, 0x1e, 0x93, 0xde, 0x21, 0xf3, 0x9e, 0x88, 0xda, 0xf1, 0x64, 0x77, 0x41, 0x75, 0x69, 0x85,
+	0x9a, 0xd1, 0x04, 0x1b, 0x21, 0xf3, 0x1c, 0x21, 0xe1, 0xf4, 0x93, 0x20, 0xdd, 0xc5, 0x9a, 0x5d,
+	0x0c, 0xd3, 0xc2, 0xa7, 0x49, 0xc0, 0x5a, 0x3f, 0xf3, 0xe0, 0x7f, 0xc9, 0x03, 0x9f, 0x83, 0x42,
+	0x1a, 0x06, 0x78, 0x4d, 0x26, 0x63, 0x31, 0x59, 0x7a, 0x79, 0x11, 0x48, 0xa5, 0xcc, 0xfa, 0xdb,
+	0x2f, 0xbf, 0x3e, 0xac, 0x54, 0xe0, 0x75, 0x14, 0xd1, 0x90, 0xa2, 0x88, 0x06, 0x7d, 0xb6, 0xe7,
+	0xd2, 0x84, 0xa0, 0x69, 0x68, 0xe1, 0x1b, 0xb0, 0x3e, 0x1b, 0x1a, 0x58, 0x99, 0xd2, 0x65, 0x24,
+	0x4d, 0x37, 0x96, 0xc1, 0x4a, 0xb3, 0x21, 0x35, 0xb7, 0x61, 0x3d, 0x53, 0x73, 0x3e, 0x86, 0x90,
+	0x80, 0x55, 0x75, 0xb7, 0x70, 0x66, 0x8a, 0xf9, 0xb4, 0xe8, 0x9b, 0x19, 0x88, 0x12, 0xdb, 0x96,
+	0x62, 0x55, 0x58, 0xc9, 0x14, 0x1b, 0xaf, 0x1e, 0xbe, 0xd7, 0x00, 0x5c, 0xdc, 0x08, 0xac, 0x4f,
+	0x89, 0x97, 0x6e, 0x54, 0xbf, 0xf1, 0xe7, 0x22, 0x65, 0xa4, 0x29, 0x8d, 0x34, 0xe0, 0x6e, 0xa6,
+	0x91, 0xde, 0xa4, 0xd1, 0x19, 0xaf, 0x9e, 0xb5, 0x1f, 0x9e, 0x0e, 0x0d, 0xed, 0x6c, 0x68, 0x68,
+	0x3f, 0x86, 0x86, 0xf6, 0x6e, 0x64, 0xe4, 0xce, 0x46, 0x46, 0xee, 0xeb, 0xc8, 0xc8, 0x3d, 0xbb,
+	0x35, 0x93, 0xcd, 0x47, 0x92, 0xa9, 0x23, 0x1e, 0x16, 0x97, 0x06, 0xb3, 0xc4, 0x03, 0x49, 0x2d,
+	0xb9, 0xba, 0x05, 0xf9, 0xf2, 0xec, 0xff, 0x1e, 0x00, 0x35, 0xa5, 0x6a, 0x4d, 0xf7, 0x04, 0x00,
 	0x00,
 }
 
@@ -155,6 +427,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MinGasPrices queries the minimum gas prices accepted by the network.
+	MinGasPrices(ctx context.Context, in *QueryMinGasPricesRequest, opts ...grpc.CallOption) (*QueryMinGasPricesResponse, error)
+	// BaseFee queries the current base fee and the one expected for the following block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// DisallowedMsgTypes queries the type URLs of the messages rejected by the network.
+	DisallowedMsgTypes(ctx context.Context, in *QueryDisallowedMsgTypesRequest, opts ...grpc.CallOption) (*QueryDisallowedMsgTypesResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +452,43 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) MinGasPrices(ctx context.Context, in *QueryMinGasPricesRequest, opts ...grpc.CallOption) (*QueryMinGasPricesResponse, error) {
+	out := new(QueryMinGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/tax.Query/MinGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/tax.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisallowedMsgTypes(ctx context.Context, in *QueryDisallowedMsgTypesRequest, opts ...grpc.CallOption) (*QueryDisallowedMsgTypesResponse, error) {
+	out := new(QueryDisallowedMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/tax.Query/DisallowedMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MinGasPrices queries the minimum gas prices accepted by the network.
+	MinGasPrices(context.Context, *QueryMinGasPricesRequest) (*QueryMinGasPricesResponse, error)
+	// BaseFee queries the current base fee and the one expected for the following block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// DisallowedMsgTypes queries the type URLs of the messages rejected by the network.
+	DisallowedMsgTypes(context.Context, *QueryDisallowedMsgTypesRequest) (*QueryDisallowedMsgTypesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +498,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) MinGasPrices(ctx context.Context, req *QueryMinGasPricesRequest) (*QueryMinGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (*UnimplementedQueryServer) DisallowedMsgTypes(ctx context.Context, req *QueryDisallowedMsgTypesRequest) (*QueryDisallowedMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisallowedMsgTypes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,6 +530,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MinGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tax.Query/MinGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinGasPrices(ctx, req.(*QueryMinGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tax.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisallowedMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisallowedMsgTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisallowedMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tax.Query/DisallowedMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisallowedMsgTypes(ctx, req.(*QueryDisallowedMsgTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tax.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -218,6 +592,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "MinGasPrices",
+			Handler:    _Query_MinGasPrices_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+		{
+			MethodName: "DisallowedMsgTypes",
+			Handler:    _Query_DisallowedMsgTypes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tax/query.proto",
@@ -279,38 +665,289 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMinGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryMinGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMinGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NextBaseFee.Size()
+		i -= size
+		if _, err := m.NextBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisallowedMsgTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisallowedMsgTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisallowedMsgTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisallowedMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisallowedMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisallowedMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMinGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NextBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDisallowedMsgTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDisallowedMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
@@ -449,6 +1086,440 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMinGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisallowedMsgTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisallowedMsgTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisallowedMsgTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisallowedMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisallowedMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisallowedMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
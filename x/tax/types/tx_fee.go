@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UnusedGasRefund returns the part of the fee refunded for the gas left unused by the transaction.
+// Nothing is refunded if the transaction ran out of gas.
+func (f TxFee) UnusedGasRefund(gasUsed uint64, refundRate sdk.Dec) sdk.Coin {
+	if f.GasLimit == 0 || gasUsed >= f.GasLimit {
+		return sdk.NewCoin(f.Fee.Denom, sdk.ZeroInt())
+	}
+
+	unusedGas := sdk.NewIntFromUint64(f.GasLimit - gasUsed)
+	gasLimit := sdk.NewIntFromUint64(f.GasLimit)
+	refund := refundRate.MulInt(f.Fee.Amount).MulInt(unusedGas).QuoInt(gasLimit).TruncateInt()
+
+	return sdk.NewCoin(f.Fee.Denom, refund)
+}
+
+// GasUsed returns the gas used by the transaction given the gas consumed by the block
+// until the next transaction. It never exceeds the gas limit of the transaction.
+func (f TxFee) GasUsed(nextBlockGasUsed uint64) uint64 {
+	if nextBlockGasUsed <= f.BlockGasUsed {
+		return 0
+	}
+
+	gasUsed := nextBlockGasUsed - f.BlockGasUsed
+	if gasUsed > f.GasLimit {
+		return f.GasLimit
+	}
+
+	return gasUsed
+}
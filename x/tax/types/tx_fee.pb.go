@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tax/tx_fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TxFee is the fee paid by a transaction delivered in the current block.
+// It is kept until the end of the block, when the gas used by the transaction is known,
+// to refund the fee of the unused gas and tax the fee actually consumed.
+type TxFee struct {
+	// payer is the account the fee was deducted from, i.e. the fee granter if there is one.
+	Payer    string     `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	Fee      types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+	GasLimit uint64     `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// block_gas_used is the gas consumed by the block before the transaction was delivered.
+	BlockGasUsed uint64 `protobuf:"varint,4,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+}
+
+func (m *TxFee) Reset()         { *m = TxFee{} }
+func (m *TxFee) String() string { return proto.CompactTextString(m) }
+func (*TxFee) ProtoMessage()    {}
+func (*TxFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f1fdccf21d494b, []int{0}
+}
+func (m *TxFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFee.Merge(m, src)
+}
+func (m *TxFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFee proto.InternalMessageInfo
+
+func (m *TxFee) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *TxFee) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *TxFee) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *TxFee) GetBlockGasUsed() uint64 {
+	if m != nil {
+		return m.BlockGasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TxFee)(nil), "tax.TxFee")
+}
+
+func init() { proto.RegisterFile("tax/tx_fee.proto", fileDescriptor_95f1fdccf21d494b) }
+
+var fileDescriptor_95f1fdccf21d494b = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x2c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
+	0x18, 0x86, 0x1b, 0xb7, 0x89, 0x8b, 0x22, 0x52, 0x76, 0xa8, 0x13, 0xe2, 0x10, 0x0f, 0xbb, 0x98,
+	0x38, 0xfd, 0x07, 0x13, 0x14, 0x44, 0x44, 0x86, 0x5e, 0xbc, 0x94, 0x34, 0xfb, 0x56, 0x8b, 0x6d,
+	0xbf, 0xd2, 0xa4, 0xd2, 0xfd, 0x0b, 0xc1, 0x3f, 0xb5, 0xe3, 0x8e, 0x9e, 0x44, 0xda, 0x3f, 0x22,
+	0x49, 0xbd, 0xe5, 0xc9, 0xfb, 0xf2, 0xc0, 0xfb, 0xd1, 0x23, 0x23, 0x6b, 0x61, 0xea, 0x70, 0x05,
+	0xc0, 0x8b, 0x12, 0x0d, 0xfa, 0x3d, 0x23, 0xeb, 0xf1, 0x28, 0xc6, 0x18, 0x1d, 0x0b, 0xfb, 0xea,
+	0xa2, 0x31, 0x53, 0xa8, 0x33, 0xd4, 0x22, 0x92, 0x1a, 0xc4, 0xc7, 0x2c, 0x02, 0x23, 0x67, 0x42,
+	0x61, 0x92, 0x77, 0xf9, 0xd9, 0x17, 0xa1, 0x83, 0xe7, 0xfa, 0x16, 0xc0, 0x1f, 0xd1, 0x41, 0x21,
+	0xd7, 0x50, 0x06, 0x64, 0x42, 0xa6, 0xc3, 0x45, 0x07, 0xfe, 0x8c, 0xf6, 0x56, 0x00, 0xc1, 0xce,
+	0x84, 0x4c, 0xf7, 0xaf, 0x8e, 0x79, 0x67, 0xe3, 0xd6, 0xc6, 0xff, 0x6d, 0xfc, 0x06, 0x93, 0x7c,
+	0xde, 0xdf, 0xfc, 0x9c, 0x7a, 0x0b, 0xdb, 0xf5, 0x4f, 0xe8, 0x30, 0x96, 0x3a, 0x4c, 0x93, 0x2c,
+	0x31, 0x41, 0x6f, 0x42, 0xa6, 0xfd, 0xc5, 0x5e, 0x2c, 0xf5, 0x83, 0x65, 0xff, 0x9c, 0x1e, 0x46,
+	0x29, 0xaa, 0xf7, 0xd0, 0x56, 0x2a, 0x0d, 0xcb, 0xa0, 0xef, 0x1a, 0x07, 0xee, 0xf7, 0x4e, 0xea,
+	0x17, 0x0d, 0xcb, 0xf9, 0xfd, 0xa6, 0x61, 0x64, 0xdb, 0x30, 0xf2, 0xdb, 0x30, 0xf2, 0xd9, 0x32,
+	0x6f, 0xdb, 0x32, 0xef, 0xbb, 0x65, 0xde, 0xeb, 0x65, 0x9c, 0x98, 0xb7, 0x2a, 0xe2, 0x0a, 0x33,
+	0xf1, 0x88, 0x69, 0xa5, 0x2f, 0x9e, 0xec, 0x0e, 0x85, 0xa9, 0xc8, 0x1d, 0x2a, 0x2c, 0x41, 0xd4,
+	0xc2, 0xdd, 0x68, 0x5d, 0x80, 0x8e, 0x76, 0xdd, 0xd0, 0xeb, 0xbf, 0x01, 0x00, 0xd1, 0x54, 0x7e,
+	0xac, 0x37, 0x01, 0x00, 0x00,
+}
+
+func (m *TxFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintTxFee(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTxFee(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTxFee(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTxFee(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTxFee(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovTxFee(uint64(m.GasLimit))
+	}
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovTxFee(uint64(m.BlockGasUsed))
+	}
+	return n
+}
+
+func sovTxFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxFee(x uint64) (n int) {
+	return sovTxFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TxFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
+			}
+			m.BlockGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxFee = fmt.Errorf("proto: unexpected end of group")
+)
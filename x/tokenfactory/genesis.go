@@ -0,0 +1,26 @@
+package tokenfactory
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// ensure the module account, minting and burning the factory denoms, is set
+	k.GetModuleAccount(ctx)
+	k.SetParams(ctx, genState.Params)
+
+	for _, denom := range genState.FactoryDenoms {
+		if err := k.SetDenom(ctx, denom.Denom, denom.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the tokenfactory module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllDenoms(ctx))
+}
@@ -0,0 +1,30 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func TestGenesis(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	genesisState := *types.NewGenesisState(types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("unls", 100))), []types.GenesisDenom{
+		{Denom: "factory/" + creator + "/lpn", AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator}},
+		{Denom: "factory/" + creator + "/lpp", AuthorityMetadata: types.DenomAuthorityMetadata{}},
+	})
+
+	tokenfactory.InitGenesis(ctx, app.TokenFactoryKeeper, genesisState)
+	require.NotNil(t, app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName))
+	require.Len(t, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, creator), 2)
+
+	got := tokenfactory.ExportGenesis(ctx, app.TokenFactoryKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
+}
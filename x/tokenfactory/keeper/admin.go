@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Mint mints tokens of a factory denom to the given address. Only the admin of the denom may mint.
+func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, mintTo sdk.AccAddress) error {
+	if err := k.checkAdmin(ctx, sender, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintTo, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyMintTo, mintTo.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// Burn burns tokens of a factory denom held by its admin.
+func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.checkAdmin(ctx, sender, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurnFrom, sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ChangeAdmin hands the administration of a factory denom over to another account.
+// An empty admin renounces it, after which the denom can no longer be minted, burned or described.
+func (k Keeper) ChangeAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string, newAdmin string) error {
+	if err := k.checkAdmin(ctx, sender, denom); err != nil {
+		return err
+	}
+
+	metadata := types.DenomAuthorityMetadata{Admin: newAdmin}
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidAdmin, err.Error())
+	}
+	k.setAuthorityMetadata(ctx, denom, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+		),
+	)
+
+	return nil
+}
+
+// SetMetadata sets the bank metadata of a factory denom, which must keep the denom as its base.
+func (k Keeper) SetMetadata(ctx sdk.Context, sender sdk.AccAddress, metadata banktypes.Metadata) error {
+	if err := k.checkAdmin(ctx, sender, metadata.Base); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) checkAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != sender.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+
+	return nil
+}
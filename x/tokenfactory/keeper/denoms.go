@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CreateDenom creates the factory denom factory/{creator}/{subdenom} with the creator as admin.
+// The denom creation fee is sent from the creator to the tax treasury.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if k.hasAuthorityMetadata(ctx, denom) || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		treasury, err := sdk.AccAddressFromBech32(k.taxKeeper.ContractAddress(ctx))
+		if err != nil {
+			return "", err
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, creator, treasury, fee); err != nil {
+			return "", sdkerrors.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+	})
+	k.setDenom(ctx, denom, creator.String(), types.DenomAuthorityMetadata{Admin: creator.String()})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+
+	return denom, nil
+}
+
+// GetAuthorityMetadata returns the authority metadata of a factory denom.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenomAuthorityMetadataKey(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "denom %s does not exist", denom)
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, nil
+}
+
+func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	ctx.KVStore(k.storeKey).Set(types.DenomAuthorityMetadataKey(denom), k.cdc.MustMarshal(&metadata))
+}
+
+func (k Keeper) hasAuthorityMetadata(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DenomAuthorityMetadataKey(denom))
+}
+
+// SetDenom registers an existing factory denom, as of the genesis state.
+func (k Keeper) SetDenom(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	creator, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	k.setDenom(ctx, denom, creator, metadata)
+	return nil
+}
+
+func (k Keeper) setDenom(ctx sdk.Context, denom, creator string, metadata types.DenomAuthorityMetadata) {
+	k.setAuthorityMetadata(ctx, denom, metadata)
+	ctx.KVStore(k.storeKey).Set(types.CreatorDenomKey(creator, denom), []byte{0x01})
+}
+
+// GetDenomsFromCreator returns the factory denoms created by an account.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator string) []string {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreatorDenomsPrefix(creator)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+
+	return denoms
+}
+
+// GetAllDenoms returns all factory denoms with their authority metadata.
+func (k Keeper) GetAllDenoms(ctx sdk.Context) []types.GenesisDenom {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomAuthorityMetadataPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []types.GenesisDenom{}
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+		denoms = append(denoms, types.GenesisDenom{Denom: string(iterator.Key()), AuthorityMetadata: metadata})
+	}
+
+	return denoms
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func TestCreateDenom(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	k := app.TokenFactoryKeeper
+	fee := sdk.NewCoins(sdk.NewInt64Coin("unls", 100))
+	k.SetParams(ctx, types.NewParams(fee))
+	treasury := sdk.MustAccAddressFromBech32(app.TaxKeeper.ContractAddress(ctx))
+
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err := k.CreateDenom(ctx, creator, "lpn")
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, creator, fee.Add(fee...)))
+	denom, err := k.CreateDenom(ctx, creator, "lpn")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator.String()+"/lpn", denom)
+	require.Equal(t, fee, app.BankKeeper.GetAllBalances(ctx, creator))
+	require.Equal(t, fee, app.BankKeeper.GetAllBalances(ctx, treasury))
+
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, creator.String(), metadata.Admin)
+	bankMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, denom, bankMetadata.Base)
+
+	_, err = k.CreateDenom(ctx, creator, "lpn")
+	require.ErrorIs(t, err, types.ErrDenomExists)
+
+	require.Equal(t, []string{denom}, k.GetDenomsFromCreator(ctx, creator.String()))
+	require.Empty(t, k.GetDenomsFromCreator(ctx, treasury.String()))
+}
+
+func TestDenomAdministration(t *testing.T) {
+	app, ctx := nolusapp.CreateTestApp(true, t.TempDir())
+	k := app.TokenFactoryKeeper
+	k.SetParams(ctx, types.DefaultParams())
+
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := k.CreateDenom(ctx, admin, "receipt")
+	require.NoError(t, err)
+	amount := sdk.NewInt64Coin(denom, 1000)
+
+	require.ErrorIs(t, k.Mint(ctx, other, amount, other), types.ErrUnauthorized)
+	require.ErrorIs(t, k.Mint(ctx, admin, sdk.NewInt64Coin("unls", 1), admin), types.ErrInvalidDenom)
+	require.NoError(t, k.Mint(ctx, admin, amount, admin))
+	require.NoError(t, k.Mint(ctx, admin, amount, other))
+	require.Equal(t, amount, app.BankKeeper.GetBalance(ctx, other, denom))
+	require.Equal(t, amount.Add(amount), app.BankKeeper.GetSupply(ctx, denom))
+
+	require.ErrorIs(t, k.Burn(ctx, other, amount), types.ErrUnauthorized)
+	require.NoError(t, k.Burn(ctx, admin, amount))
+	require.True(t, app.BankKeeper.GetBalance(ctx, admin, denom).IsZero())
+	require.Equal(t, amount, app.BankKeeper.GetSupply(ctx, denom))
+
+	metadata := banktypes.Metadata{
+		Description: "LPP receipt",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "receipt", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "receipt",
+		Name:    "receipt",
+		Symbol:  "RCPT",
+	}
+	require.ErrorIs(t, k.SetMetadata(ctx, other, metadata), types.ErrUnauthorized)
+	invalid := metadata
+	invalid.Display = "unknown"
+	require.ErrorIs(t, k.SetMetadata(ctx, admin, invalid), types.ErrInvalidMetadata)
+	require.NoError(t, k.SetMetadata(ctx, admin, metadata))
+	stored, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, metadata, stored)
+
+	require.ErrorIs(t, k.ChangeAdmin(ctx, other, denom, other.String()), types.ErrUnauthorized)
+	require.ErrorIs(t, k.ChangeAdmin(ctx, admin, denom, "nolus1invalid"), types.ErrInvalidAdmin)
+	require.NoError(t, k.ChangeAdmin(ctx, admin, denom, other.String()))
+	require.ErrorIs(t, k.Mint(ctx, admin, amount, admin), types.ErrUnauthorized)
+	require.NoError(t, k.Mint(ctx, other, amount, other))
+
+	// once renounced, the denom can no longer be administered
+	require.NoError(t, k.ChangeAdmin(ctx, other, denom, ""))
+	require.ErrorIs(t, k.Mint(ctx, other, amount, other), types.ErrUnauthorized)
+	require.ErrorIs(t, k.ChangeAdmin(ctx, other, denom, other.String()), types.ErrUnauthorized)
+}
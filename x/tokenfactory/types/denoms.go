@@ -0,0 +1,59 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DenomPrefix is the first part of every factory denom.
+	DenomPrefix = "factory"
+	// DenomSeparator separates the parts of a factory denom.
+	DenomSeparator = "/"
+
+	// MaxSubdenomLength is the maximum length of the subdenom chosen by the creator.
+	MaxSubdenomLength = 44
+	// MaxCreatorLength is the maximum length of the bech32 address of a creator, as of a contract.
+	MaxCreatorLength = 75
+)
+
+// GetTokenDenom returns the factory denom namespaced as factory/{creator}/{subdenom}.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator too long, max length is %d bytes", MaxCreatorLength)
+	}
+	if strings.Contains(creator, DenomSeparator) {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator %s contains %s", creator, DenomSeparator)
+	}
+
+	denom := strings.Join([]string{DenomPrefix, creator, subdenom}, DenomSeparator)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a factory denom.
+// The subdenom may contain the separator, the creator may not.
+func DeconstructDenom(denom string) (creator string, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, DenomSeparator, 3)
+	if len(parts) < 3 || parts[0] != DenomPrefix {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s is not in the form %s/{creator}/{subdenom}", denom, DenomPrefix)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(parts[1]); err != nil {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator %s: %s", parts[1], err)
+	}
+
+	return parts[1], parts[2], nil
+}
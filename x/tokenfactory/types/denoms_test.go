@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	params.SetAddressPrefixes()
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	testCases := []struct {
+		title    string
+		creator  string
+		subdenom string
+		expPass  bool
+	}{
+		{title: "valid subdenom", creator: creator, subdenom: "lpn", expPass: true},
+		{title: "subdenom with separator", creator: creator, subdenom: "pool/lpn", expPass: true},
+		{title: "empty subdenom", creator: creator, subdenom: "", expPass: true},
+		{title: "too long subdenom", creator: creator, subdenom: strings.Repeat("a", types.MaxSubdenomLength+1), expPass: false},
+		{title: "invalid subdenom character", creator: creator, subdenom: "lpn!", expPass: false},
+		{title: "creator with separator", creator: "nolus/1", subdenom: "lpn", expPass: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if !tc.expPass {
+				require.ErrorIs(t, err, types.ErrInvalidDenom)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "factory/"+tc.creator+"/"+tc.subdenom, denom)
+
+			gotCreator, gotSubdenom, err := types.DeconstructDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, tc.creator, gotCreator)
+			require.Equal(t, tc.subdenom, gotSubdenom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	params.SetAddressPrefixes()
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	for _, denom := range []string{
+		"unls",
+		"factory/" + creator,
+		"ibc/" + creator + "/lpn",
+		"factory/nolus1invalid/lpn",
+	} {
+		_, _, err := types.DeconstructDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidDenom, denom)
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	params.SetAddressPrefixes()
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	denom := types.GenesisDenom{
+		Denom:             "factory/" + creator + "/lpn",
+		AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator},
+	}
+
+	require.NoError(t, types.DefaultGenesis().Validate())
+	require.NoError(t, types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{denom}).Validate())
+
+	renounced := denom
+	renounced.AuthorityMetadata.Admin = ""
+	require.NoError(t, types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{renounced}).Validate())
+
+	require.Error(t, types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{denom, denom}).Validate())
+
+	invalidAdmin := denom
+	invalidAdmin.AuthorityMetadata.Admin = "nolus1invalid"
+	require.Error(t, types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{invalidAdmin}).Validate())
+
+	invalidFee := types.NewParams(sdk.Coins{sdk.Coin{Denom: "unls", Amount: sdk.NewInt(-1)}})
+	require.Error(t, types.NewGenesisState(invalidFee, nil).Validate())
+}
@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors.
+var (
+	ErrInvalidDenom    = sdkerrors.Register(ModuleName, 1, "invalid denom")
+	ErrDenomExists     = sdkerrors.Register(ModuleName, 2, "denom already exists")
+	ErrUnauthorized    = sdkerrors.Register(ModuleName, 3, "unauthorized account")
+	ErrInvalidMetadata = sdkerrors.Register(ModuleName, 4, "invalid denom metadata")
+	ErrInvalidAdmin    = sdkerrors.Register(ModuleName, 5, "invalid denom admin")
+)
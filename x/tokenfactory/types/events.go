@@ -0,0 +1,16 @@
+package types
+
+// Tokenfactory module event types.
+const (
+	EventTypeCreateDenom = "create_denom"
+	EventTypeMint        = "tf_mint"
+	EventTypeBurn        = "tf_burn"
+	EventTypeChangeAdmin = "change_admin"
+	EventTypeSetMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator  = "creator"
+	AttributeKeyNewAdmin = "new_admin"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyMintTo   = "mint_to"
+	AttributeKeyBurnFrom = "burn_from"
+)
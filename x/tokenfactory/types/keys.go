@@ -0,0 +1,38 @@
+package types
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the tokenfactory module.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
+
+var (
+	// DenomAuthorityMetadataPrefix is the store prefix of the authority metadata of the factory denoms.
+	DenomAuthorityMetadataPrefix = []byte{0x01}
+
+	// CreatorDenomPrefix is the store prefix of the factory denoms indexed by their creator.
+	CreatorDenomPrefix = []byte{0x02}
+)
+
+// DenomAuthorityMetadataKey returns the store key of the authority metadata of a factory denom.
+func DenomAuthorityMetadataKey(denom string) []byte {
+	return append(append([]byte{}, DenomAuthorityMetadataPrefix...), []byte(denom)...)
+}
+
+// CreatorDenomsPrefix returns the store prefix of the factory denoms created by an account.
+func CreatorDenomsPrefix(creator string) []byte {
+	return append(append([]byte{}, CreatorDenomPrefix...), []byte(creator+DenomSeparator)...)
+}
+
+// CreatorDenomKey returns the store key of a factory denom in the index of its creator.
+func CreatorDenomKey(creator, denom string) []byte {
+	return append(CreatorDenomsPrefix(creator), []byte(denom)...)
+}